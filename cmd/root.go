@@ -63,6 +63,8 @@ func NewCmd() *cobra.Command {
 			strings.Join(logLevels, ", "), DefaultLogLevel),
 	)
 
+	cmd.AddCommand(newUploadCmd())
+
 	cobra.OnInitialize(
 		initLogger,
 		func() { config.Init(configFile) },
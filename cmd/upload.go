@@ -0,0 +1,170 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/vadimklimov/cpi-navigator/internal/config"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/util"
+)
+
+// Set using command flags of the upload command at runtime.
+var (
+	uploadArtifactType string
+	uploadArtifactID   string
+	uploadArtifactName string
+	uploadPackageID    string
+	uploadConfirmed    bool
+)
+
+var errUploadCancelled = errors.New("upload cancelled")
+
+func newUploadCmd() *cobra.Command {
+	supportedArtifactTypes := api.SupportedArtifactTypes()
+	artifactTypes := []string{
+		supportedArtifactTypes.Designtime.IntegrationFlow.Name,
+		supportedArtifactTypes.Designtime.ValueMapping.Name,
+		supportedArtifactTypes.Designtime.MessageMapping.Name,
+		supportedArtifactTypes.Designtime.ScriptCollection.Name,
+	}
+
+	uploadCmd := &cobra.Command{
+		Use:   "upload FILE",
+		Short: "Upload an integration artifact from a zip file",
+		Long: "Update content of an existing design-time integration artifact from a zip file, " +
+			"or create a new artifact in the content package if the package is provided. " +
+			"Requires write mode to be enabled in the configuration.",
+		Args: cobra.ExactArgs(1),
+		PreRunE: func(_ *cobra.Command, _ []string) error {
+			if !slices.Contains(artifactTypes, uploadArtifactType) {
+				return fmt.Errorf("unsupported artifact type %s (supported: %s)",
+					uploadArtifactType, strings.Join(artifactTypes, ", "))
+			}
+
+			return nil
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			err := upload(cmd, args[0])
+			if errors.Is(err, errUploadCancelled) {
+				fmt.Fprintln(cmd.OutOrStdout(), "Upload cancelled")
+
+				return
+			}
+
+			if err != nil {
+				logger.Fatal("Upload failed", "err", err)
+			}
+		},
+	}
+
+	uploadCmd.Flags().StringVarP(&uploadArtifactType, "type", "t", supportedArtifactTypes.Designtime.IntegrationFlow.Name,
+		fmt.Sprintf("artifact type (supported: %s)", strings.Join(artifactTypes, ", ")),
+	)
+
+	uploadCmd.Flags().StringVar(&uploadArtifactID, "id", "",
+		"artifact ID [default: file name without extension]",
+	)
+
+	uploadCmd.Flags().StringVar(&uploadArtifactName, "name", "",
+		"artifact name [default: artifact ID when creating, current name when updating]",
+	)
+
+	uploadCmd.Flags().StringVarP(&uploadPackageID, "package", "p", "",
+		"ID of the content package to create a new artifact in [default: update an existing artifact]",
+	)
+
+	uploadCmd.Flags().BoolVarP(&uploadConfirmed, "yes", "y", false,
+		"upload without asking for confirmation",
+	)
+
+	return uploadCmd
+}
+
+func upload(cmd *cobra.Command, path string) error {
+	if !config.TenantWriteMode() {
+		return errors.New("write mode is disabled, enable it in the configuration to upload artifacts")
+	}
+
+	content, err := util.ReadArchive(path)
+	if err != nil {
+		return err
+	}
+
+	artifactID := uploadArtifactID
+	if artifactID == "" {
+		artifactID = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	ctx := context.Background()
+
+	if uploadPackageID != "" {
+		name := uploadArtifactName
+		if name == "" {
+			name = artifactID
+		}
+
+		if err := confirm(cmd, fmt.Sprintf("Create %s %s in content package %s from %s?",
+			uploadArtifactType, artifactID, uploadPackageID, path)); err != nil {
+			return err
+		}
+
+		if err := api.CreateArtifact(ctx, uploadArtifactType, uploadPackageID, artifactID, name, content); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(cmd.OutOrStdout(), "Created %s %s in content package %s\n",
+			uploadArtifactType, artifactID, uploadPackageID)
+
+		return nil
+	}
+
+	artifact, err := api.IntegrationArtifactByID(ctx, uploadArtifactType, artifactID)
+	if err != nil {
+		return err
+	}
+
+	name := uploadArtifactName
+	if name == "" {
+		name = artifact.Name
+	}
+
+	if err := confirm(cmd, fmt.Sprintf("Update content of %s %s (version %s) from %s?",
+		uploadArtifactType, artifactID, artifact.Version, path)); err != nil {
+		return err
+	}
+
+	if err := api.UpdateArtifact(ctx, uploadArtifactType, artifactID, name, content); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "Updated %s %s\n", uploadArtifactType, artifactID)
+
+	return nil
+}
+
+// confirm asks the question unless the upload is confirmed with the flag.
+func confirm(cmd *cobra.Command, question string) error {
+	if uploadConfirmed {
+		return nil
+	}
+
+	fmt.Fprintf(cmd.OutOrStdout(), "%s [y/N] ", question)
+
+	answer, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && answer == "" {
+		return errUploadCancelled
+	}
+
+	if !slices.Contains([]string{"y", "yes"}, strings.ToLower(strings.TrimSpace(answer))) {
+		return errUploadCancelled
+	}
+
+	return nil
+}
@@ -6,32 +6,50 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/log"
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	Tenant *Tenant `mapstructure:"tenant"`
-	UI     *UI     `mapstructure:"ui"`
+	Tenant   *Tenant   `mapstructure:"tenant"`
+	UI       *UI       `mapstructure:"ui"`
+	Download *Download `mapstructure:"download"`
 }
 
 type Tenant struct {
-	Name         string   `mapstructure:"name"`
-	WebUIURL     *url.URL `mapstructure:"webui_url"`
-	BaseURL      *url.URL `mapstructure:"base_url"`
-	TokenURL     *url.URL `mapstructure:"token_url"`
-	ClientID     string   `mapstructure:"client_id"`
-	ClientSecret string   `mapstructure:"client_secret"`
+	Name         string        `mapstructure:"name"`
+	WebUIURL     *url.URL      `mapstructure:"webui_url"`
+	BaseURL      *url.URL      `mapstructure:"base_url"`
+	TokenURL     *url.URL      `mapstructure:"token_url"`
+	ClientID     string        `mapstructure:"client_id"`
+	ClientSecret string        `mapstructure:"client_secret"`
+	Timeout      time.Duration `mapstructure:"timeout"`
+	Retry        Retry         `mapstructure:"retry"`
+	WriteMode    bool          `mapstructure:"write_mode"`
+}
+
+type Retry struct {
+	MaxRetries  int           `mapstructure:"max_retries"`
+	WaitTime    time.Duration `mapstructure:"wait_time"`
+	MaxWaitTime time.Duration `mapstructure:"max_wait_time"`
 }
 
 type UI struct {
-	Layout Layout `mapstructure:"layout"`
-	Panes  Panes  `mapstructure:",squash"`
+	Layout   Layout   `mapstructure:"layout"`
+	Panes    Panes    `mapstructure:",squash"`
+	Search   Search   `mapstructure:"search"`
+	Keystore Keystore `mapstructure:"keystore"`
+	Queues   Queues   `mapstructure:"queues"`
 }
 
 type Layout string
 
+type Download struct {
+	Directory string `mapstructure:"directory"`
+}
+
 type Panes struct {
 	Packages  PackagesPane  `mapstructure:"packages_pane"`
 	Artifacts ArtifactsPane `mapstructure:"artifacts_pane"`
@@ -52,6 +70,18 @@ type Sort struct {
 
 type SortOrder string
 
+type Search struct {
+	Concurrency int `mapstructure:"concurrency"`
+}
+
+type Keystore struct {
+	ExpiryWarningDays int `mapstructure:"expiry_warning_days"`
+}
+
+type Queues struct {
+	UsageWarningPercent int `mapstructure:"usage_warning_percent"`
+}
+
 const (
 	LayoutNormal  Layout = "normal"
 	LayoutCompact Layout = "compact"
@@ -69,12 +99,26 @@ const (
 	DefaultConfigFileExt  = "yaml"
 )
 
+const (
+	DefaultTenantTimeout          = 60 * time.Second
+	DefaultTenantRetryMaxRetries  = 3
+	DefaultTenantRetryWaitTime    = 500 * time.Millisecond
+	DefaultTenantRetryMaxWaitTime = 30 * time.Second
+)
+
+const DefaultUISearchConcurrency = 4
+
+const DefaultUIKeystoreExpiryWarningDays = 30
+
+const DefaultUIQueuesUsageWarningPercent = 80
+
 var cfg *Config
 
 func Init(configFile string) {
 	cfg = &Config{
-		Tenant: &Tenant{},
-		UI:     &UI{},
+		Tenant:   &Tenant{},
+		UI:       &UI{},
+		Download: &Download{},
 	}
 
 	if err := cfg.load(configFile); err != nil {
@@ -112,6 +156,26 @@ func TenantClientSecret() string {
 	return cfg.Tenant.ClientSecret
 }
 
+func TenantTimeout() time.Duration {
+	return cfg.Tenant.Timeout
+}
+
+func TenantRetryMaxRetries() int {
+	return cfg.Tenant.Retry.MaxRetries
+}
+
+func TenantRetryWaitTime() time.Duration {
+	return cfg.Tenant.Retry.WaitTime
+}
+
+func TenantRetryMaxWaitTime() time.Duration {
+	return cfg.Tenant.Retry.MaxWaitTime
+}
+
+func TenantWriteMode() bool {
+	return cfg.Tenant.WriteMode
+}
+
 func UILayout() Layout {
 	return cfg.UI.Layout
 }
@@ -132,6 +196,22 @@ func UIArtifactsPaneSortOrder() SortOrder {
 	return cfg.UI.Panes.Artifacts.Sort.Order
 }
 
+func UISearchConcurrency() int {
+	return cfg.UI.Search.Concurrency
+}
+
+func UIKeystoreExpiryWarningDays() int {
+	return cfg.UI.Keystore.ExpiryWarningDays
+}
+
+func UIQueuesUsageWarningPercent() int {
+	return cfg.UI.Queues.UsageWarningPercent
+}
+
+func DownloadDirectory() string {
+	return cfg.Download.Directory
+}
+
 func (c *Config) load(configFile string) error {
 	if configFile != "" {
 		viper.SetConfigFile(configFile)
@@ -201,6 +281,24 @@ func (c *Config) setDefaults() {
 		c.Tenant.Name = strings.Split(c.Tenant.WebUIURL.Hostname(), ".")[0]
 	}
 
+	// Set tenant request timeout.
+	if c.Tenant.Timeout <= 0 {
+		c.Tenant.Timeout = DefaultTenantTimeout
+	}
+
+	// Set tenant retry policy. Retries are disabled by explicitly setting max retries to 0.
+	if !viper.IsSet("tenant.retry.max_retries") || c.Tenant.Retry.MaxRetries < 0 {
+		c.Tenant.Retry.MaxRetries = DefaultTenantRetryMaxRetries
+	}
+
+	if c.Tenant.Retry.WaitTime <= 0 {
+		c.Tenant.Retry.WaitTime = DefaultTenantRetryWaitTime
+	}
+
+	if c.Tenant.Retry.MaxWaitTime < c.Tenant.Retry.WaitTime {
+		c.Tenant.Retry.MaxWaitTime = max(DefaultTenantRetryMaxWaitTime, c.Tenant.Retry.WaitTime)
+	}
+
 	// Set UI layout.
 	layout := Layout(strings.ToLower(string(c.UI.Layout)))
 
@@ -240,4 +338,29 @@ func (c *Config) setDefaults() {
 	default:
 		c.UI.Panes.Artifacts.Sort.Order = SortOrderAscending
 	}
+
+	// Set number of content packages that are indexed concurrently for search.
+	if c.UI.Search.Concurrency <= 0 {
+		c.UI.Search.Concurrency = DefaultUISearchConcurrency
+	}
+
+	// Set number of days before expiry that keystore entries are highlighted.
+	if c.UI.Keystore.ExpiryWarningDays <= 0 {
+		c.UI.Keystore.ExpiryWarningDays = DefaultUIKeystoreExpiryWarningDays
+	}
+
+	// Set usage of JMS queues, as a percentage of their maximum size, from which they are highlighted.
+	if c.UI.Queues.UsageWarningPercent <= 0 || c.UI.Queues.UsageWarningPercent > 100 {
+		c.UI.Queues.UsageWarningPercent = DefaultUIQueuesUsageWarningPercent
+	}
+
+	// Set download directory. The home directory can be referred to with a tilde.
+	if c.Download.Directory == "" {
+		c.Download.Directory = "."
+	}
+
+	if homeDir, err := os.UserHomeDir(); err == nil &&
+		(c.Download.Directory == "~" || strings.HasPrefix(c.Download.Directory, "~/")) {
+		c.Download.Directory = filepath.Join(homeDir, strings.TrimPrefix(c.Download.Directory, "~"))
+	}
 }
@@ -11,6 +11,7 @@ import (
 func composeDecodeHook() mapstructure.DecodeHookFunc {
 	return mapstructure.ComposeDecodeHookFunc(
 		decodeStringToURLHook(),
+		mapstructure.StringToTimeDurationHookFunc(),
 	)
 }
 
@@ -1,8 +1,13 @@
 package api
 
 import (
+	"context"
+	"encoding/base64"
 	"fmt"
+	"io"
+	"net/http"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/vadimklimov/cpi-navigator/internal/cpi/client"
 )
 
@@ -18,13 +23,17 @@ type IntegrationArtifact struct {
 	ModifiedAt  int64  `json:"ModifiedAt,string"`
 }
 
-func IntegrationArtifactsByPackageAndType(packageID, artifactType string) ([]IntegrationArtifact, error) {
-	var responseBody struct {
-		Root struct {
-			Results []IntegrationArtifact `json:"results"`
-		} `json:"d"`
-	}
+func IntegrationArtifactsByPackageAndType(ctx context.Context, packageID, artifactType string,
+) ([]IntegrationArtifact, error) {
+	return fetchAll(func(next string) (*Page[IntegrationArtifact], error) {
+		return IntegrationArtifactsByPackageAndTypePage(ctx, packageID, artifactType, next)
+	})
+}
 
+// IntegrationArtifactsByPackageAndTypePage fetches a single page of integration artifacts of the given type
+// in the content package. An empty continuation link requests the first page.
+func IntegrationArtifactsByPackageAndTypePage(ctx context.Context, packageID, artifactType, next string,
+) (*Page[IntegrationArtifact], error) {
 	var entitySetName string
 
 	supportedArtifactTypes := SupportedArtifactTypes()
@@ -40,21 +49,104 @@ func IntegrationArtifactsByPackageAndType(packageID, artifactType string) ([]Int
 		entitySetName = supportedArtifactTypes.Designtime.ScriptCollection.EntitySetName
 	}
 
-	res, err := client.NewClient().R().
-		SetResult(&responseBody).
+	request := client.GetInstance().R(ctx).
 		SetPathParams(map[string]string{
-			"package":   packageID,
+			"package":   escapeKey(packageID),
 			"entitySet": entitySetName,
-		}).
-		SetQueryParam("$format", "json").
-		Get("IntegrationPackages('{package}')/{entitySet}")
+		})
+
+	return fetchPage[IntegrationArtifact](request, "IntegrationPackages('{package}')/{entitySet}", next)
+}
+
+// DownloadArtifact streams the zip archive of the active version of the design-time artifact to the writer.
+func DownloadArtifact(ctx context.Context, artifactType, artifactID string, writer io.Writer, progress Progress,
+) error {
+	designtimeType, ok := designtimeArtifactType(artifactType)
+	if !ok {
+		return fmt.Errorf("artifacts of type %s can't be downloaded", artifactType)
+	}
+
+	request := client.GetInstance().R(ctx).
+		SetPathParams(map[string]string{
+			"entitySet": designtimeType.EntitySetName,
+			"id":        escapeKey(artifactID),
+		})
+
+	return downloadValue(request, "{entitySet}(Id='{id}',Version='active')/$value", writer, progress)
+}
+
+// UpdateArtifact replaces content of the design-time artifact with the zip archive. The version of the artifact
+// is taken from the archive.
+func UpdateArtifact(ctx context.Context, artifactType, artifactID, name string, content []byte) error {
+	designtimeType, ok := designtimeArtifactType(artifactType)
+	if !ok {
+		return fmt.Errorf("artifacts of type %s can't be updated", artifactType)
+	}
+
+	res, err := client.GetInstance().Modify(ctx, http.MethodPut, "{entitySet}(Id='{id}',Version='active')",
+		func(request *resty.Request) {
+			request.
+				SetPathParams(map[string]string{
+					"entitySet": designtimeType.EntitySetName,
+					"id":        escapeKey(artifactID),
+				}).
+				SetBody(map[string]string{
+					"Name":            name,
+					"ArtifactContent": base64.StdEncoding.EncodeToString(content),
+				})
+		})
 	if err != nil {
-		return nil, fmt.Errorf("error when calling %s: %w", res.Request.URL, err)
+		return fmt.Errorf("error updating artifact %s: %w", artifactID, err)
 	}
 
 	if res.IsError() {
-		return nil, fmt.Errorf("error when calling %s: %s", res.Request.URL, res.Status())
+		return newError(res)
 	}
 
-	return responseBody.Root.Results, nil
+	return nil
+}
+
+// CreateArtifact creates a design-time artifact of the given type in the content package from the zip archive.
+func CreateArtifact(ctx context.Context, artifactType, packageID, artifactID, name string, content []byte) error {
+	designtimeType, ok := designtimeArtifactType(artifactType)
+	if !ok {
+		return fmt.Errorf("artifacts of type %s can't be created", artifactType)
+	}
+
+	res, err := client.GetInstance().Modify(ctx, http.MethodPost, "{entitySet}",
+		func(request *resty.Request) {
+			request.
+				SetPathParam("entitySet", designtimeType.EntitySetName).
+				SetBody(map[string]string{
+					"Id":              artifactID,
+					"Name":            name,
+					"PackageId":       packageID,
+					"ArtifactContent": base64.StdEncoding.EncodeToString(content),
+				})
+		})
+	if err != nil {
+		return fmt.Errorf("error creating artifact %s: %w", artifactID, err)
+	}
+
+	if res.IsError() {
+		return newError(res)
+	}
+
+	return nil
+}
+
+// IntegrationArtifactByID fetches the active version of the design-time artifact of the given type.
+func IntegrationArtifactByID(ctx context.Context, artifactType, artifactID string) (*IntegrationArtifact, error) {
+	designtimeType, ok := designtimeArtifactType(artifactType)
+	if !ok {
+		return nil, fmt.Errorf("unsupported artifact type %s", artifactType)
+	}
+
+	request := client.GetInstance().R(ctx).
+		SetPathParams(map[string]string{
+			"entitySet": designtimeType.EntitySetName,
+			"id":        escapeKey(artifactID),
+		})
+
+	return fetchEntity[IntegrationArtifact](request, "{entitySet}(Id='{id}',Version='active')")
 }
@@ -0,0 +1,61 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/client"
+)
+
+// Configuration is an externalized parameter of an integration flow.
+type Configuration struct {
+	ParameterKey   string `json:"ParameterKey"`
+	ParameterValue string `json:"ParameterValue"`
+	DataType       string `json:"DataType"`
+}
+
+// Data types of externalized parameters that restrict their values.
+const (
+	ConfigurationDataTypeInteger = "xsd:integer"
+	ConfigurationDataTypeLong    = "xsd:long"
+	ConfigurationDataTypeBoolean = "xsd:boolean"
+)
+
+// Configurations fetches externalized parameters of the active version of the integration flow.
+func Configurations(ctx context.Context, artifactID string) ([]Configuration, error) {
+	return fetchAll(func(next string) (*Page[Configuration], error) {
+		request := client.GetInstance().R(ctx).
+			SetPathParam("id", escapeKey(artifactID))
+
+		return fetchPage[Configuration](request,
+			"IntegrationDesigntimeArtifacts(Id='{id}',Version='active')/Configurations", next)
+	})
+}
+
+// UpdateConfiguration sets the value of the externalized parameter of the active version of the integration flow.
+func UpdateConfiguration(ctx context.Context, artifactID string, configuration Configuration) error {
+	res, err := client.GetInstance().Modify(ctx, http.MethodPut,
+		"IntegrationDesigntimeArtifacts(Id='{id}',Version='active')/$links/Configurations('{key}')",
+		func(request *resty.Request) {
+			request.
+				SetPathParams(map[string]string{
+					"id":  escapeKey(artifactID),
+					"key": escapeKey(configuration.ParameterKey),
+				}).
+				SetBody(map[string]string{
+					"ParameterValue": configuration.ParameterValue,
+					"DataType":       configuration.DataType,
+				})
+		})
+	if err != nil {
+		return fmt.Errorf("error updating parameter %s: %w", configuration.ParameterKey, err)
+	}
+
+	if res.IsError() {
+		return newError(res)
+	}
+
+	return nil
+}
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/client"
+)
+
+// Credential is a user credential, an OAuth2 client credential or a secure parameter of the tenant
+// security material. Only metadata is kept: passwords, client secrets and secure parameter values
+// are never decoded from responses.
+type Credential struct {
+	Name        string
+	Type        string
+	Kind        string
+	Description string
+	// User is the user of user credentials and the client ID of OAuth2 client credentials.
+	User                 string
+	CompanyID            string
+	TokenURL             string
+	ClientAuthentication string
+	Scope                string
+	Resource             string
+	Audience             string
+	Status               string
+	LastModifiedBy       string
+	LastModifiedTime     DateTime
+}
+
+// Types of credentials.
+const (
+	CredentialTypeUser            = "UserCredentials"
+	CredentialTypeOAuth2Client    = "OAuth2ClientCredentials"
+	CredentialTypeSecureParameter = "SecureParameters"
+)
+
+// securityArtifactDescriptor holds deployment information of security material.
+type securityArtifactDescriptor struct {
+	DeployedBy string   `json:"DeployedBy"`
+	DeployedOn DateTime `json:"DeployedOn"`
+	Status     string   `json:"Status"`
+}
+
+// The fields below deliberately leave out Password, ClientSecret and SecureParam.
+type (
+	userCredential struct {
+		Name        string                     `json:"Name"`
+		Kind        string                     `json:"Kind"`
+		Description string                     `json:"Description"`
+		User        string                     `json:"User"`
+		CompanyID   string                     `json:"CompanyId"`
+		Descriptor  securityArtifactDescriptor `json:"SecurityArtifactDescriptor"`
+	}
+	oAuth2ClientCredential struct {
+		Name                 string                     `json:"Name"`
+		Description          string                     `json:"Description"`
+		TokenServiceURL      string                     `json:"TokenServiceUrl"`
+		ClientID             string                     `json:"ClientId"`
+		ClientAuthentication string                     `json:"ClientAuthentication"`
+		Scope                string                     `json:"Scope"`
+		Resource             string                     `json:"Resource"`
+		Audience             string                     `json:"Audience"`
+		Descriptor           securityArtifactDescriptor `json:"SecurityArtifactDescriptor"`
+	}
+	secureParameter struct {
+		securityArtifactDescriptor
+
+		Name        string `json:"Name"`
+		Description string `json:"Description"`
+	}
+)
+
+// Credentials fetches metadata of user credentials, OAuth2 client credentials and secure parameters.
+func Credentials(ctx context.Context) ([]Credential, error) {
+	users, err := fetchAll(func(next string) (*Page[userCredential], error) {
+		return fetchPage[userCredential](client.GetInstance().R(ctx), CredentialTypeUser, next)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	clients, err := fetchAll(func(next string) (*Page[oAuth2ClientCredential], error) {
+		return fetchPage[oAuth2ClientCredential](client.GetInstance().R(ctx), CredentialTypeOAuth2Client, next)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	parameters, err := fetchAll(func(next string) (*Page[secureParameter], error) {
+		return fetchPage[secureParameter](client.GetInstance().R(ctx), CredentialTypeSecureParameter, next)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	credentials := make([]Credential, 0, len(users)+len(clients)+len(parameters))
+
+	for _, user := range users {
+		credentials = append(credentials, Credential{
+			Name:             user.Name,
+			Type:             CredentialTypeUser,
+			Kind:             user.Kind,
+			Description:      user.Description,
+			User:             user.User,
+			CompanyID:        user.CompanyID,
+			Status:           user.Descriptor.Status,
+			LastModifiedBy:   user.Descriptor.DeployedBy,
+			LastModifiedTime: user.Descriptor.DeployedOn,
+		})
+	}
+
+	for _, clientCredential := range clients {
+		credentials = append(credentials, Credential{
+			Name:                 clientCredential.Name,
+			Type:                 CredentialTypeOAuth2Client,
+			Description:          clientCredential.Description,
+			User:                 clientCredential.ClientID,
+			TokenURL:             clientCredential.TokenServiceURL,
+			ClientAuthentication: clientCredential.ClientAuthentication,
+			Scope:                clientCredential.Scope,
+			Resource:             clientCredential.Resource,
+			Audience:             clientCredential.Audience,
+			Status:               clientCredential.Descriptor.Status,
+			LastModifiedBy:       clientCredential.Descriptor.DeployedBy,
+			LastModifiedTime:     clientCredential.Descriptor.DeployedOn,
+		})
+	}
+
+	for _, parameter := range parameters {
+		credentials = append(credentials, Credential{
+			Name:             parameter.Name,
+			Type:             CredentialTypeSecureParameter,
+			Description:      parameter.Description,
+			Status:           parameter.Status,
+			LastModifiedBy:   parameter.DeployedBy,
+			LastModifiedTime: parameter.DeployedOn,
+		})
+	}
+
+	return credentials, nil
+}
@@ -0,0 +1,103 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/client"
+)
+
+// DataStore is a data store of the runtime. Data stores that are visible to all integration flows
+// are not owned by any.
+type DataStore struct {
+	DataStoreName           string `json:"DataStoreName"`
+	IntegrationFlow         string `json:"IntegrationFlow"`
+	Type                    string `json:"Type"`
+	Visibility              string `json:"Visibility"`
+	NumberOfMessages        int    `json:"NumberOfMessages"`
+	NumberOfOverdueMessages int    `json:"NumberOfOverdueMessages"`
+}
+
+// DataStoreEntry is an entry of a data store, whose payload is stored as a zip archive.
+type DataStoreEntry struct {
+	ID              string   `json:"Id"`
+	DataStoreName   string   `json:"DataStoreName"`
+	IntegrationFlow string   `json:"IntegrationFlow"`
+	Type            string   `json:"Type"`
+	Status          string   `json:"Status"`
+	MessageID       string   `json:"MessageId"`
+	CreatedAt       DateTime `json:"CreatedAt"`
+	DueAt           DateTime `json:"DueAt"`
+	RetainUntil     DateTime `json:"RetainUntil"`
+}
+
+// Visibilities of data stores.
+const (
+	DataStoreVisibilityGlobal          = "Global"
+	DataStoreVisibilityIntegrationFlow = "Integration Flow"
+)
+
+// DataStores fetches data stores of the runtime.
+func DataStores(ctx context.Context) ([]DataStore, error) {
+	return fetchAll(func(next string) (*Page[DataStore], error) {
+		return fetchPage[DataStore](client.GetInstance().R(ctx), "DataStores", next)
+	})
+}
+
+// DataStoreEntries fetches entries of the data store.
+func DataStoreEntries(ctx context.Context, store DataStore) ([]DataStoreEntry, error) {
+	return fetchAll(func(next string) (*Page[DataStoreEntry], error) {
+		request := client.GetInstance().R(ctx).
+			SetPathParams(map[string]string{
+				"name": escapeKey(store.DataStoreName),
+				"flow": escapeKey(store.IntegrationFlow),
+				"type": escapeKey(store.Type),
+			})
+
+		return fetchPage[DataStoreEntry](request,
+			"DataStores(DataStoreName='{name}',IntegrationFlow='{flow}',Type='{type}')/Entries", next)
+	})
+}
+
+// DownloadDataStoreEntry streams the payload of the data store entry, a zip archive, to the writer.
+func DownloadDataStoreEntry(ctx context.Context, entry DataStoreEntry, writer io.Writer) error {
+	request := client.GetInstance().R(ctx).
+		SetPathParams(dataStoreEntryKey(entry))
+
+	if err := downloadValue(request, dataStoreEntryPath+"/$value", writer, nil); err != nil {
+		return fmt.Errorf("error downloading data store entry %s: %w", entry.ID, err)
+	}
+
+	return nil
+}
+
+// DeleteDataStoreEntry removes the entry from its data store.
+func DeleteDataStoreEntry(ctx context.Context, entry DataStoreEntry) error {
+	res, err := client.GetInstance().Modify(ctx, http.MethodDelete, dataStoreEntryPath,
+		func(request *resty.Request) {
+			request.SetPathParams(dataStoreEntryKey(entry))
+		})
+	if err != nil {
+		return fmt.Errorf("error deleting data store entry %s: %w", entry.ID, err)
+	}
+
+	if res.IsError() {
+		return newError(res)
+	}
+
+	return nil
+}
+
+const dataStoreEntryPath = "DataStoreEntries(Id='{id}',DataStoreName='{name}',IntegrationFlow='{flow}',Type='{type}')"
+
+func dataStoreEntryKey(entry DataStoreEntry) map[string]string {
+	return map[string]string{
+		"id":   escapeKey(entry.ID),
+		"name": escapeKey(entry.DataStoreName),
+		"flow": escapeKey(entry.IntegrationFlow),
+		"type": escapeKey(entry.Type),
+	}
+}
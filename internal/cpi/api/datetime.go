@@ -0,0 +1,59 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DateTime is a point in time that the API represents in the OData JSON format, e.g. "/Date(1700000000000)/".
+// A missing value is represented by the zero time.
+type DateTime struct {
+	time.Time
+}
+
+func (dateTime *DateTime) UnmarshalJSON(data []byte) error {
+	if bytes.Equal(data, []byte("null")) {
+		dateTime.Time = time.Time{}
+
+		return nil
+	}
+
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("error parsing date time %s: %w", data, err)
+	}
+
+	value = strings.TrimSuffix(strings.TrimPrefix(value, "/Date("), ")/")
+	if value == "" {
+		dateTime.Time = time.Time{}
+
+		return nil
+	}
+
+	// The time zone offset, if any, is informational: milliseconds are counted since the epoch in UTC.
+	if idx := strings.IndexAny(value[1:], "+-"); idx != -1 {
+		value = value[:idx+1]
+	}
+
+	milliseconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("error parsing date time %s: %w", data, err)
+	}
+
+	dateTime.Time = time.UnixMilli(milliseconds).UTC()
+
+	return nil
+}
+
+// String formats the date time in RFC 3339 format, or returns an empty string if it is missing.
+func (dateTime DateTime) String() string {
+	if dateTime.IsZero() {
+		return ""
+	}
+
+	return dateTime.Format(time.RFC3339)
+}
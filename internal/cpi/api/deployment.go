@@ -0,0 +1,122 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/client"
+)
+
+// BuildAndDeployStatus is the status of the task that builds and deploys a design-time artifact.
+type BuildAndDeployStatus struct {
+	TaskID string `json:"TaskId"`
+	Status string `json:"Status"`
+}
+
+// Statuses of build and deploy tasks that have completed.
+const (
+	BuildAndDeployStatusSuccess = "SUCCESS"
+	BuildAndDeployStatusFail    = "FAIL"
+)
+
+type runtimeErrorInformation struct {
+	Message struct {
+		MessageText string `json:"messageText"`
+	} `json:"message"`
+	Parameter []string `json:"parameter"`
+}
+
+// DeployArtifact deploys the active version of the design-time artifact of the given type to the runtime.
+// It returns the ID of the build and deploy task, which is empty if the tenant doesn't report it.
+func DeployArtifact(ctx context.Context, artifactType, artifactID string) (string, error) {
+	designtimeType, ok := designtimeArtifactType(artifactType)
+	if !ok {
+		return "", fmt.Errorf("artifacts of type %s can't be deployed", artifactType)
+	}
+
+	res, err := client.GetInstance().Modify(ctx, http.MethodPost, designtimeType.DeployFunctionName,
+		func(request *resty.Request) {
+			request.SetQueryParams(map[string]string{
+				"Id":      quote(artifactID),
+				"Version": quote("active"),
+			})
+		})
+	if err != nil {
+		return "", fmt.Errorf("error deploying artifact %s: %w", artifactID, err)
+	}
+
+	if res.IsError() {
+		return "", newError(res)
+	}
+
+	// The task ID is returned as plain text.
+	taskID := strings.Trim(strings.TrimSpace(res.String()), `"`)
+	if strings.ContainsAny(taskID, "{}<> \n") {
+		return "", nil
+	}
+
+	return taskID, nil
+}
+
+// UndeployArtifact removes the artifact from the runtime.
+func UndeployArtifact(ctx context.Context, artifactID string) error {
+	res, err := client.GetInstance().Modify(ctx, http.MethodDelete, "IntegrationRuntimeArtifacts('{id}')",
+		func(request *resty.Request) {
+			request.SetPathParam("id", escapeKey(artifactID))
+		})
+	if err != nil {
+		return fmt.Errorf("error undeploying artifact %s: %w", artifactID, err)
+	}
+
+	if res.IsError() {
+		return newError(res)
+	}
+
+	return nil
+}
+
+// DeploymentStatus fetches the status of the build and deploy task.
+func DeploymentStatus(ctx context.Context, taskID string) (*BuildAndDeployStatus, error) {
+	request := client.GetInstance().R(ctx).
+		SetPathParam("id", escapeKey(taskID))
+
+	return fetchEntity[BuildAndDeployStatus](request, "BuildAndDeployStatus(TaskId='{id}')")
+}
+
+// IntegrationRuntimeArtifactByID fetches the artifact deployed to the runtime. The tenant responds with
+// the not found error if the artifact isn't deployed.
+func IntegrationRuntimeArtifactByID(ctx context.Context, artifactID string) (*IntegrationRuntimeArtifact, error) {
+	request := client.GetInstance().R(ctx).
+		SetPathParam("id", escapeKey(artifactID))
+
+	return fetchEntity[IntegrationRuntimeArtifact](request, "IntegrationRuntimeArtifacts('{id}')")
+}
+
+// IntegrationRuntimeArtifactErrorInformation fetches the text of the error that prevented the artifact
+// from starting in the runtime.
+func IntegrationRuntimeArtifactErrorInformation(ctx context.Context, artifactID string) (string, error) {
+	request := client.GetInstance().R(ctx).
+		SetPathParam("id", escapeKey(artifactID))
+
+	value, err := fetchValue(request, "IntegrationRuntimeArtifacts('{id}')/ErrorInformation/$value")
+	if err != nil {
+		return "", err
+	}
+
+	// The error is described by a message with parameters, which carry the details.
+	// Unstructured error text is returned as is.
+	var errorInformation runtimeErrorInformation
+	if json.Unmarshal(value, &errorInformation) != nil {
+		return strings.TrimSpace(string(value)), nil
+	}
+
+	if len(errorInformation.Parameter) > 0 {
+		return strings.Join(errorInformation.Parameter, "; "), nil
+	}
+
+	return errorInformation.Message.MessageText, nil
+}
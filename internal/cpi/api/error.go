@@ -0,0 +1,95 @@
+package api
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+// Error is returned when the tenant responds to an API call with an error status.
+// It carries the details of the OData error returned in the response body.
+type Error struct {
+	StatusCode    int
+	Status        string
+	Code          string
+	Message       string
+	URL           string
+	CorrelationID string
+}
+
+type odataError struct {
+	Error struct {
+		Code    string `json:"code"`
+		Message struct {
+			Lang  string `json:"lang"`
+			Value string `json:"value"`
+		} `json:"message"`
+	} `json:"error"`
+}
+
+var correlationIDHeaders = []string{
+	"X-Correlation-ID",
+	"X-CorrelationID",
+	"X-Vcap-Request-Id",
+}
+
+func newError(res *resty.Response) *Error {
+	apiErr := &Error{
+		StatusCode: res.StatusCode(),
+		Status:     res.Status(),
+		URL:        res.Request.URL,
+	}
+
+	for _, header := range correlationIDHeaders {
+		if correlationID := res.Header().Get(header); correlationID != "" {
+			apiErr.CorrelationID = correlationID
+
+			break
+		}
+	}
+
+	var body odataError
+	if err := json.Unmarshal(res.Body(), &body); err == nil {
+		apiErr.Code = body.Error.Code
+		apiErr.Message = body.Error.Message.Value
+	}
+
+	return apiErr
+}
+
+func (e *Error) Error() string {
+	builder := strings.Builder{}
+	builder.WriteString(fmt.Sprintf("error when calling %s: %s", e.URL, e.Status))
+
+	if e.Code != "" {
+		builder.WriteString(fmt.Sprintf(" [%s]", e.Code))
+	}
+
+	if e.Message != "" {
+		builder.WriteString(": " + e.Message)
+	}
+
+	if e.CorrelationID != "" {
+		builder.WriteString(fmt.Sprintf(" (correlation ID: %s)", e.CorrelationID))
+	}
+
+	return builder.String()
+}
+
+// IsUnauthorized reports whether the call was rejected because credentials are missing, invalid or expired.
+func (e *Error) IsUnauthorized() bool {
+	return e.StatusCode == http.StatusUnauthorized
+}
+
+// IsForbidden reports whether the call was rejected because the client lacks the required role.
+func (e *Error) IsForbidden() bool {
+	return e.StatusCode == http.StatusForbidden
+}
+
+// IsNotFound reports whether the requested entity doesn't exist.
+func (e *Error) IsNotFound() bool {
+	return e.StatusCode == http.StatusNotFound
+}
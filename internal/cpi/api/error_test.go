@@ -0,0 +1,131 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestNewError(t *testing.T) {
+	tests := []struct {
+		name              string
+		statusCode        int
+		header            http.Header
+		body              string
+		wantCode          string
+		wantMessage       string
+		wantCorrelationID string
+		wantError         string
+	}{
+		{
+			name:        "odata error",
+			statusCode:  http.StatusBadRequest,
+			body:        `{"error":{"code":"Bad Request","message":{"lang":"en","value":"Invalid filter"}}}`,
+			wantCode:    "Bad Request",
+			wantMessage: "Invalid filter",
+			wantError:   "error when calling {url}: 400 Bad Request [Bad Request]: Invalid filter",
+		},
+		{
+			name:       "body that isn't json",
+			statusCode: http.StatusBadGateway,
+			body:       "<html>Bad Gateway</html>",
+			wantError:  "error when calling {url}: 502 Bad Gateway",
+		},
+		{
+			name:       "empty body",
+			statusCode: http.StatusUnauthorized,
+			wantError:  "error when calling {url}: 401 Unauthorized",
+		},
+		{
+			name:              "correlation id",
+			statusCode:        http.StatusInternalServerError,
+			header:            http.Header{"X-Correlation-Id": {"abc123"}},
+			body:              `{"error":{"code":"Internal Server Error","message":{"lang":"en","value":"Failure"}}}`,
+			wantCode:          "Internal Server Error",
+			wantMessage:       "Failure",
+			wantCorrelationID: "abc123",
+			wantError: "error when calling {url}: 500 Internal Server Error [Internal Server Error]: Failure " +
+				"(correlation ID: abc123)",
+		},
+		{
+			name:              "first correlation id header",
+			statusCode:        http.StatusNotFound,
+			header:            http.Header{"X-Vcap-Request-Id": {"vcap"}, "X-Correlationid": {"corr"}},
+			wantCorrelationID: "corr",
+			wantError:         "error when calling {url}: 404 Not Found (correlation ID: corr)",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				for name, values := range test.header {
+					w.Header()[name] = values
+				}
+
+				w.WriteHeader(test.statusCode)
+				_, _ = w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			res, err := resty.New().R().Get(server.URL + "/Items")
+			if err != nil {
+				t.Fatalf("request error = %v", err)
+			}
+
+			apiErr := newError(res)
+
+			if apiErr.StatusCode != test.statusCode {
+				t.Errorf("StatusCode = %d, want %d", apiErr.StatusCode, test.statusCode)
+			}
+
+			if apiErr.Code != test.wantCode || apiErr.Message != test.wantMessage {
+				t.Errorf("Code, Message = %q, %q, want %q, %q",
+					apiErr.Code, apiErr.Message, test.wantCode, test.wantMessage)
+			}
+
+			if apiErr.CorrelationID != test.wantCorrelationID {
+				t.Errorf("CorrelationID = %q, want %q", apiErr.CorrelationID, test.wantCorrelationID)
+			}
+
+			if apiErr.URL != server.URL+"/Items" {
+				t.Errorf("URL = %s, want %s", apiErr.URL, server.URL+"/Items")
+			}
+
+			wantError := strings.ReplaceAll(test.wantError, "{url}", server.URL+"/Items")
+			if apiErr.Error() != wantError {
+				t.Errorf("Error() = %q, want %q", apiErr.Error(), wantError)
+			}
+		})
+	}
+}
+
+func TestErrorStatus(t *testing.T) {
+	tests := []struct {
+		statusCode       int
+		wantUnauthorized bool
+		wantForbidden    bool
+		wantNotFound     bool
+	}{
+		{statusCode: http.StatusUnauthorized, wantUnauthorized: true},
+		{statusCode: http.StatusForbidden, wantForbidden: true},
+		{statusCode: http.StatusNotFound, wantNotFound: true},
+		{statusCode: http.StatusInternalServerError},
+	}
+
+	for _, test := range tests {
+		t.Run(http.StatusText(test.statusCode), func(t *testing.T) {
+			apiErr := &Error{StatusCode: test.statusCode}
+
+			if apiErr.IsUnauthorized() != test.wantUnauthorized || apiErr.IsForbidden() != test.wantForbidden ||
+				apiErr.IsNotFound() != test.wantNotFound {
+				t.Errorf("IsUnauthorized, IsForbidden, IsNotFound = %v, %v, %v, want %v, %v, %v",
+					apiErr.IsUnauthorized(), apiErr.IsForbidden(), apiErr.IsNotFound(),
+					test.wantUnauthorized, test.wantForbidden, test.wantNotFound)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/client"
+)
+
+// KeystoreEntry is a key pair, a certificate or a secret key in the tenant keystore.
+type KeystoreEntry struct {
+	Hexalias           string   `json:"Hexalias"`
+	Alias              string   `json:"Alias"`
+	Type               string   `json:"Type"`
+	Owner              string   `json:"Owner"`
+	KeyType            string   `json:"KeyType"`
+	KeySize            int      `json:"KeySize"`
+	SignatureAlgorithm string   `json:"SignatureAlgorithm"`
+	SerialNumber       string   `json:"SerialNumber"`
+	SubjectDN          string   `json:"SubjectDN"`
+	IssuerDN           string   `json:"IssuerDN"`
+	ValidNotBefore     DateTime `json:"ValidNotBefore"`
+	ValidNotAfter      DateTime `json:"ValidNotAfter"`
+	FingerprintSha256  string   `json:"FingerprintSha256"`
+	LastModifiedBy     string   `json:"LastModifiedBy"`
+	LastModifiedTime   DateTime `json:"LastModifiedTime"`
+}
+
+// Types of keystore entries.
+const (
+	KeystoreEntryTypeKeyPair     = "KeyPair"
+	KeystoreEntryTypeCertificate = "Certificate"
+	KeystoreEntryTypeSecretKey   = "SecretKey"
+)
+
+var errNotCertificate = errors.New("content is not a certificate")
+
+// KeystoreEntries fetches entries of the tenant keystore.
+func KeystoreEntries(ctx context.Context) ([]KeystoreEntry, error) {
+	return fetchAll(func(next string) (*Page[KeystoreEntry], error) {
+		return fetchPage[KeystoreEntry](client.GetInstance().R(ctx), "KeystoreEntries", next)
+	})
+}
+
+// HasCertificate reports whether the entry holds a certificate, which is the case for key pairs and certificates.
+func (entry KeystoreEntry) HasCertificate() bool {
+	return entry.Type == KeystoreEntryTypeKeyPair || entry.Type == KeystoreEntryTypeCertificate
+}
+
+// CertificatePEM fetches the public certificate of the keystore entry, PEM encoded.
+func CertificatePEM(ctx context.Context, hexalias string) ([]byte, error) {
+	request := client.GetInstance().R(ctx).
+		SetPathParam("hexalias", escapeKey(hexalias))
+
+	content, err := fetchValue(request, "CertificateResources('{hexalias}')/$value")
+	if err != nil {
+		return nil, err
+	}
+
+	return certificatePEM(content)
+}
+
+// certificatePEM encodes the certificate, which the API returns either PEM encoded or DER encoded,
+// the latter possibly in base64.
+func certificatePEM(content []byte) ([]byte, error) {
+	content = bytes.TrimSpace(content)
+
+	if block, _ := pem.Decode(content); block != nil {
+		return pem.EncodeToMemory(block), nil
+	}
+
+	if decoded, err := base64.StdEncoding.DecodeString(string(content)); err == nil {
+		content = decoded
+	}
+
+	if _, err := x509.ParseCertificate(content); err != nil {
+		return nil, fmt.Errorf("%w: %w", errNotCertificate, err)
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: content}), nil
+}
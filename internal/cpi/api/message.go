@@ -0,0 +1,243 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/client"
+)
+
+// MessageProcessingLog is the processing log of a message processed by the tenant's runtime.
+type MessageProcessingLog struct {
+	MessageGUID            string   `json:"MessageGuid"`
+	CorrelationID          string   `json:"CorrelationId"`
+	ApplicationMessageID   string   `json:"ApplicationMessageId"`
+	ApplicationMessageType string   `json:"ApplicationMessageType"`
+	LogStart               DateTime `json:"LogStart"`
+	LogEnd                 DateTime `json:"LogEnd"`
+	Sender                 string   `json:"Sender"`
+	Receiver               string   `json:"Receiver"`
+	IntegrationFlowName    string   `json:"IntegrationFlowName"`
+	Status                 string   `json:"Status"`
+	CustomStatus           string   `json:"CustomStatus"`
+	LogLevel               string   `json:"LogLevel"`
+	AlternateWebLink       string   `json:"AlternateWebLink"`
+	IntegrationArtifact    struct {
+		ID          string `json:"Id"`
+		Name        string `json:"Name"`
+		Type        string `json:"Type"`
+		PackageID   string `json:"PackageId"`
+		PackageName string `json:"PackageName"`
+	} `json:"IntegrationArtifact"`
+}
+
+// MessageProcessingLogAttachment is a payload or a log that was attached to the message processing log
+// by the integration flow, e.g. by a script.
+type MessageProcessingLogAttachment struct {
+	ID          string   `json:"Id"`
+	MessageGUID string   `json:"MessageGuid"`
+	TimeStamp   DateTime `json:"TimeStamp"`
+	Name        string   `json:"Name"`
+	ContentType string   `json:"ContentType"`
+	PayloadSize int64    `json:"PayloadSize"`
+}
+
+// MessageProcessingLogCustomHeaderProperty is a custom header property that was set by the integration flow
+// to make the message searchable.
+type MessageProcessingLogCustomHeaderProperty struct {
+	ID    string `json:"Id"`
+	Name  string `json:"Name"`
+	Value string `json:"Value"`
+}
+
+// MessageProcessingLogRun is a single processing attempt of the message.
+type MessageProcessingLogRun struct {
+	ID           string   `json:"Id"`
+	RunStart     DateTime `json:"RunStart"`
+	RunStop      DateTime `json:"RunStop"`
+	LogLevel     string   `json:"LogLevel"`
+	OverallState string   `json:"OverallState"`
+}
+
+// MessageProcessingLogRunStep is a step of the integration flow that was executed during the run.
+type MessageProcessingLogRunStep struct {
+	RunID       string   `json:"RunId"`
+	ChildCount  int      `json:"ChildCount"`
+	StepStart   DateTime `json:"StepStart"`
+	StepStop    DateTime `json:"StepStop"`
+	StepID      string   `json:"StepId"`
+	ModelStepID string   `json:"ModelStepId"`
+	BranchID    string   `json:"BranchId"`
+	Status      string   `json:"Status"`
+	Error       string   `json:"Error"`
+	Activity    string   `json:"Activity"`
+}
+
+// Statuses of processed messages.
+const (
+	MessageStatusCompleted  = "COMPLETED"
+	MessageStatusFailed     = "FAILED"
+	MessageStatusRetry      = "RETRY"
+	MessageStatusProcessing = "PROCESSING"
+	MessageStatusEscalated  = "ESCALATED"
+)
+
+// MessageFilter restricts message processing logs that are fetched. Empty fields don't restrict logs.
+type MessageFilter struct {
+	ArtifactID           string
+	Status               string
+	CorrelationID        string
+	ApplicationMessageID string
+	CustomHeaderName     string
+	CustomHeaderValue    string
+	From                 time.Time
+	To                   time.Time
+}
+
+// String builds the OData $filter expression for the filter.
+func (filter MessageFilter) String() string {
+	conditions := make([]string, 0)
+
+	if filter.ArtifactID != "" {
+		conditions = append(conditions, "IntegrationArtifact/Id eq "+quote(filter.ArtifactID))
+	}
+
+	if filter.Status != "" {
+		conditions = append(conditions, "Status eq "+quote(filter.Status))
+	}
+
+	if filter.CorrelationID != "" {
+		conditions = append(conditions, "CorrelationId eq "+quote(filter.CorrelationID))
+	}
+
+	if filter.ApplicationMessageID != "" {
+		conditions = append(conditions, "ApplicationMessageId eq "+quote(filter.ApplicationMessageID))
+	}
+
+	// A message matches if any of its custom header properties has the name and the value.
+	if filter.CustomHeaderName != "" || filter.CustomHeaderValue != "" {
+		propertyConditions := make([]string, 0)
+
+		if filter.CustomHeaderName != "" {
+			propertyConditions = append(propertyConditions, "p/Name eq "+quote(filter.CustomHeaderName))
+		}
+
+		if filter.CustomHeaderValue != "" {
+			propertyConditions = append(propertyConditions, "p/Value eq "+quote(filter.CustomHeaderValue))
+		}
+
+		conditions = append(conditions,
+			"CustomHeaderProperties/any(p: "+strings.Join(propertyConditions, " and ")+")")
+	}
+
+	if !filter.From.IsZero() {
+		conditions = append(conditions, "LogStart ge "+datetime(filter.From))
+	}
+
+	if !filter.To.IsZero() {
+		conditions = append(conditions, "LogStart le "+datetime(filter.To))
+	}
+
+	return strings.Join(conditions, " and ")
+}
+
+// MessageProcessingLogsPage fetches up to top message processing logs that match the filter, latest first,
+// skipping the given number of logs. Fewer logs than requested are returned for the last page.
+func MessageProcessingLogsPage(ctx context.Context, filter MessageFilter, top, skip int,
+) ([]MessageProcessingLog, error) {
+	request := client.GetInstance().R(ctx).
+		SetQueryParams(map[string]string{
+			"$orderby": "LogStart desc",
+			"$top":     strconv.Itoa(top),
+			"$skip":    strconv.Itoa(skip),
+		})
+
+	if expression := filter.String(); expression != "" {
+		request.SetQueryParam("$filter", expression)
+	}
+
+	page, err := fetchPage[MessageProcessingLog](request, "MessageProcessingLogs", "")
+	if err != nil {
+		return nil, err
+	}
+
+	return page.Results, nil
+}
+
+// MessageProcessingLogErrorInformation fetches the error text of the message processing log.
+// An empty text is returned if processing of the message didn't fail.
+func MessageProcessingLogErrorInformation(ctx context.Context, messageGUID string) (string, error) {
+	request := client.GetInstance().R(ctx).
+		SetPathParam("guid", escapeKey(messageGUID))
+
+	value, err := fetchValue(request, "MessageProcessingLogs('{guid}')/ErrorInformation/$value")
+	if err != nil {
+		var apiErr *Error
+		if errors.As(err, &apiErr) && apiErr.IsNotFound() {
+			return "", nil
+		}
+
+		return "", err
+	}
+
+	return string(value), nil
+}
+
+func MessageProcessingLogAttachments(ctx context.Context, messageGUID string,
+) ([]MessageProcessingLogAttachment, error) {
+	return fetchAll(func(next string) (*Page[MessageProcessingLogAttachment], error) {
+		request := client.GetInstance().R(ctx).
+			SetPathParam("guid", escapeKey(messageGUID))
+
+		return fetchPage[MessageProcessingLogAttachment](request, "MessageProcessingLogs('{guid}')/Attachments", next)
+	})
+}
+
+// MessageProcessingLogAttachmentContent fetches the content of the attachment.
+func MessageProcessingLogAttachmentContent(ctx context.Context, attachmentID string) ([]byte, error) {
+	request := client.GetInstance().R(ctx).
+		SetPathParam("id", escapeKey(attachmentID))
+
+	return fetchValue(request, "MessageProcessingLogAttachments('{id}')/$value")
+}
+
+func MessageProcessingLogCustomHeaderProperties(ctx context.Context, messageGUID string,
+) ([]MessageProcessingLogCustomHeaderProperty, error) {
+	return fetchAll(func(next string) (*Page[MessageProcessingLogCustomHeaderProperty], error) {
+		request := client.GetInstance().R(ctx).
+			SetPathParam("guid", escapeKey(messageGUID))
+
+		return fetchPage[MessageProcessingLogCustomHeaderProperty](request,
+			"MessageProcessingLogs('{guid}')/CustomHeaderProperties", next)
+	})
+}
+
+func MessageProcessingLogRuns(ctx context.Context, messageGUID string) ([]MessageProcessingLogRun, error) {
+	return fetchAll(func(next string) (*Page[MessageProcessingLogRun], error) {
+		request := client.GetInstance().R(ctx).
+			SetPathParam("guid", escapeKey(messageGUID)).
+			SetQueryParam("$orderby", "RunStart")
+
+		return fetchPage[MessageProcessingLogRun](request, "MessageProcessingLogs('{guid}')/Runs", next)
+	})
+}
+
+// MessageProcessingLogRunSteps fetches steps executed during the run in the order of their execution.
+func MessageProcessingLogRunSteps(ctx context.Context, runID string) ([]MessageProcessingLogRunStep, error) {
+	return fetchAll(func(next string) (*Page[MessageProcessingLogRunStep], error) {
+		request := client.GetInstance().R(ctx).
+			SetPathParam("id", escapeKey(runID)).
+			SetQueryParam("$orderby", "StepStart,ChildCount")
+
+		return fetchPage[MessageProcessingLogRunStep](request, "MessageProcessingLogRuns('{id}')/RunSteps", next)
+	})
+}
+
+// datetime formats the time as an OData date time literal.
+func datetime(t time.Time) string {
+	return fmt.Sprintf("datetime'%s'", t.UTC().Format("2006-01-02T15:04:05"))
+}
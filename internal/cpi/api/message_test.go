@@ -0,0 +1,98 @@
+package api
+
+import (
+	"testing"
+	"time"
+)
+
+func TestMessageFilterString(t *testing.T) {
+	from := time.Date(2024, time.March, 1, 8, 30, 0, 0, time.UTC)
+	to := time.Date(2024, time.March, 2, 17, 45, 59, 0, time.FixedZone("CET", 3600))
+
+	tests := []struct {
+		name   string
+		filter MessageFilter
+		want   string
+	}{
+		{
+			name:   "empty",
+			filter: MessageFilter{},
+			want:   "",
+		},
+		{
+			name:   "artifact",
+			filter: MessageFilter{ArtifactID: "IF_Orders"},
+			want:   "IntegrationArtifact/Id eq 'IF_Orders'",
+		},
+		{
+			name:   "status",
+			filter: MessageFilter{Status: MessageStatusFailed},
+			want:   "Status eq 'FAILED'",
+		},
+		{
+			name:   "correlation id",
+			filter: MessageFilter{CorrelationID: "AGcorr1"},
+			want:   "CorrelationId eq 'AGcorr1'",
+		},
+		{
+			name:   "application message id",
+			filter: MessageFilter{ApplicationMessageID: "4500001234"},
+			want:   "ApplicationMessageId eq '4500001234'",
+		},
+		{
+			name:   "custom header name",
+			filter: MessageFilter{CustomHeaderName: "OrderNumber"},
+			want:   "CustomHeaderProperties/any(p: p/Name eq 'OrderNumber')",
+		},
+		{
+			name:   "custom header value",
+			filter: MessageFilter{CustomHeaderValue: "4500001234"},
+			want:   "CustomHeaderProperties/any(p: p/Value eq '4500001234')",
+		},
+		{
+			name:   "custom header name and value",
+			filter: MessageFilter{CustomHeaderName: "OrderNumber", CustomHeaderValue: "4500001234"},
+			want:   "CustomHeaderProperties/any(p: p/Name eq 'OrderNumber' and p/Value eq '4500001234')",
+		},
+		{
+			name:   "from",
+			filter: MessageFilter{From: from},
+			want:   "LogStart ge datetime'2024-03-01T08:30:00'",
+		},
+		{
+			name:   "to in another time zone",
+			filter: MessageFilter{To: to},
+			want:   "LogStart le datetime'2024-03-02T16:45:59'",
+		},
+		{
+			name:   "quotes",
+			filter: MessageFilter{CorrelationID: "it's", CustomHeaderValue: "O'Brien"},
+			want:   "CorrelationId eq 'it''s' and CustomHeaderProperties/any(p: p/Value eq 'O''Brien')",
+		},
+		{
+			name: "all fields",
+			filter: MessageFilter{
+				ArtifactID:           "IF_Orders",
+				Status:               MessageStatusCompleted,
+				CorrelationID:        "AGcorr1",
+				ApplicationMessageID: "4500001234",
+				CustomHeaderName:     "Customer",
+				CustomHeaderValue:    "ACME",
+				From:                 from,
+				To:                   to,
+			},
+			want: "IntegrationArtifact/Id eq 'IF_Orders' and Status eq 'COMPLETED' and CorrelationId eq 'AGcorr1'" +
+				" and ApplicationMessageId eq '4500001234'" +
+				" and CustomHeaderProperties/any(p: p/Name eq 'Customer' and p/Value eq 'ACME')" +
+				" and LogStart ge datetime'2024-03-01T08:30:00' and LogStart le datetime'2024-03-02T16:45:59'",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := test.filter.String(); got != test.want {
+				t.Errorf("String() = %q, want %q", got, test.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,187 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const downloadBufferSize = 32 * 1024
+
+// Page is a single page of an OData collection. Next holds the continuation link
+// returned by the server and is empty for the last page.
+type Page[T any] struct {
+	Results []T
+	Next    string
+}
+
+type collection[T any] struct {
+	Root struct {
+		Results []T    `json:"results"`
+		Next    string `json:"__next"`
+	} `json:"d"`
+}
+
+// fetchPage fetches the first page of the collection using the prepared request and the path,
+// or the page referenced by the continuation link if it is provided. The continuation link carries
+// the query of the collection, so query parameters of the prepared request are only sent for the first page.
+func fetchPage[T any](request *resty.Request, path, next string) (*Page[T], error) {
+	var responseBody collection[T]
+
+	if next != "" {
+		nextURL, err := url.Parse(next)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing continuation link %s: %w", next, err)
+		}
+
+		query := nextURL.Query()
+		query.Set("$format", "json")
+		nextURL.RawQuery = query.Encode()
+
+		path = nextURL.String()
+		request.QueryParam = url.Values{}
+	} else {
+		request.SetQueryParam("$format", "json")
+	}
+
+	res, err := request.
+		SetResult(&responseBody).
+		Get(path)
+	if err != nil {
+		return nil, fmt.Errorf("error when calling %s: %w", res.Request.URL, err)
+	}
+
+	if res.IsError() {
+		return nil, newError(res)
+	}
+
+	return &Page[T]{
+		Results: responseBody.Root.Results,
+		Next:    responseBody.Root.Next,
+	}, nil
+}
+
+// fetchAll follows continuation links until all pages of the collection are fetched.
+func fetchAll[T any](fetch func(next string) (*Page[T], error)) ([]T, error) {
+	results := make([]T, 0)
+	next := ""
+
+	for {
+		page, err := fetch(next)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, page.Results...)
+
+		if page.Next == "" {
+			return results, nil
+		}
+
+		next = page.Next
+	}
+}
+
+// fetchValue fetches the raw value of the entity or property using the prepared request and the path.
+func fetchValue(request *resty.Request, path string) ([]byte, error) {
+	res, err := request.Get(path)
+	if err != nil {
+		return nil, fmt.Errorf("error when calling %s: %w", res.Request.URL, err)
+	}
+
+	if res.IsError() {
+		return nil, newError(res)
+	}
+
+	return res.Body(), nil
+}
+
+type entity[T any] struct {
+	Root T `json:"d"`
+}
+
+// fetchEntity fetches a single entity using the prepared request and the path.
+func fetchEntity[T any](request *resty.Request, path string) (*T, error) {
+	var responseBody entity[T]
+
+	res, err := request.
+		SetQueryParam("$format", "json").
+		SetResult(&responseBody).
+		Get(path)
+	if err != nil {
+		return nil, fmt.Errorf("error when calling %s: %w", res.Request.URL, err)
+	}
+
+	if res.IsError() {
+		return nil, newError(res)
+	}
+
+	return &responseBody.Root, nil
+}
+
+// Progress is notified of the number of bytes downloaded so far. Total is -1 if the size is unknown.
+type Progress func(downloaded, total int64)
+
+// downloadValue streams the raw value of the entity using the prepared request and the path to the writer,
+// reporting progress if progress is not nil.
+func downloadValue(request *resty.Request, path string, writer io.Writer, progress Progress) error {
+	res, err := request.
+		SetDoNotParseResponse(true).
+		Get(path)
+	if err != nil {
+		return fmt.Errorf("error when calling %s: %w", res.Request.URL, err)
+	}
+
+	body := res.RawBody()
+	defer body.Close()
+
+	if res.IsError() {
+		// The error details are only available in the body, which hasn't been read.
+		if content, err := io.ReadAll(body); err == nil {
+			res.SetBody(content)
+		}
+
+		return newError(res)
+	}
+
+	total := res.RawResponse.ContentLength
+	downloaded := int64(0)
+	buffer := make([]byte, downloadBufferSize)
+
+	for {
+		n, err := body.Read(buffer)
+		if n > 0 {
+			if _, err := writer.Write(buffer[:n]); err != nil {
+				return err
+			}
+
+			downloaded += int64(n)
+
+			if progress != nil {
+				progress(downloaded, total)
+			}
+		}
+
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error when downloading %s: %w", res.Request.URL, err)
+		}
+	}
+}
+
+// escapeKey escapes quotes in the value of a key property, which is enclosed in quotes.
+func escapeKey(value string) string {
+	return strings.ReplaceAll(value, "'", "''")
+}
+
+// quote formats the value as an OData string literal.
+func quote(value string) string {
+	return "'" + escapeKey(value) + "'"
+}
@@ -0,0 +1,186 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/go-resty/resty/v2"
+)
+
+type testItem struct {
+	ID string `json:"Id"`
+}
+
+func TestFetchPage(t *testing.T) {
+	tests := []struct {
+		name      string
+		next      func(serverURL string) string
+		wantPath  string
+		wantQuery url.Values
+	}{
+		{
+			name:     "first page",
+			next:     func(string) string { return "" },
+			wantPath: "/Items",
+			wantQuery: url.Values{
+				"$format": {"json"},
+				"$filter": {"Name eq 'A'"},
+			},
+		},
+		{
+			name:     "relative continuation link",
+			next:     func(string) string { return "Items?$skiptoken=2" },
+			wantPath: "/Items",
+			wantQuery: url.Values{
+				"$format":    {"json"},
+				"$skiptoken": {"2"},
+			},
+		},
+		{
+			name:     "absolute continuation link",
+			next:     func(serverURL string) string { return serverURL + "/Other?$skiptoken=3&$format=xml" },
+			wantPath: "/Other",
+			wantQuery: url.Values{
+				"$format":    {"json"},
+				"$skiptoken": {"3"},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var gotPath string
+
+			var gotQuery url.Values
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotPath, gotQuery = r.URL.Path, r.URL.Query()
+
+				w.Header().Set("Content-Type", "application/json")
+				fmt.Fprint(w, `{"d":{"results":[{"Id":"1"},{"Id":"2"}],"__next":"Items?$skiptoken=4"}}`)
+			}))
+			defer server.Close()
+
+			request := resty.New().SetBaseURL(server.URL).R().SetQueryParam("$filter", "Name eq 'A'")
+
+			page, err := fetchPage[testItem](request, "Items", test.next(server.URL))
+			if err != nil {
+				t.Fatalf("fetchPage() error = %v", err)
+			}
+
+			if gotPath != test.wantPath {
+				t.Errorf("path = %s, want %s", gotPath, test.wantPath)
+			}
+
+			if !reflect.DeepEqual(gotQuery, test.wantQuery) {
+				t.Errorf("query = %v, want %v", gotQuery, test.wantQuery)
+			}
+
+			wantPage := &Page[testItem]{Results: []testItem{{ID: "1"}, {ID: "2"}}, Next: "Items?$skiptoken=4"}
+			if !reflect.DeepEqual(page, wantPage) {
+				t.Errorf("fetchPage() = %+v, want %+v", page, wantPage)
+			}
+		})
+	}
+}
+
+func TestFetchPageError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":{"code":"Not Found","message":{"lang":"en","value":"Package not found"}}}`)
+	}))
+	defer server.Close()
+
+	_, err := fetchPage[testItem](resty.New().SetBaseURL(server.URL).R(), "Items", "")
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("fetchPage() error = %v, want *Error", err)
+	}
+
+	if !apiErr.IsNotFound() || apiErr.Message != "Package not found" {
+		t.Errorf("fetchPage() error = %+v, want not found with the message of the tenant", apiErr)
+	}
+}
+
+func TestFetchAll(t *testing.T) {
+	errFetch := errors.New("fetch failed")
+
+	tests := []struct {
+		name      string
+		pages     map[string]*Page[int]
+		errAt     string
+		want      []int
+		wantNexts []string
+		wantErr   error
+	}{
+		{
+			name:      "single page",
+			pages:     map[string]*Page[int]{"": {Results: []int{1, 2}}},
+			want:      []int{1, 2},
+			wantNexts: []string{""},
+		},
+		{
+			name: "empty collection",
+			pages: map[string]*Page[int]{
+				"": {},
+			},
+			want:      []int{},
+			wantNexts: []string{""},
+		},
+		{
+			name: "continuation links",
+			pages: map[string]*Page[int]{
+				"":       {Results: []int{1, 2}, Next: "page2"},
+				"page2":  {Results: []int{3}, Next: "page3"},
+				"page3":  {Results: []int{4, 5}},
+				"unused": {Results: []int{6}},
+			},
+			want:      []int{1, 2, 3, 4, 5},
+			wantNexts: []string{"", "page2", "page3"},
+		},
+		{
+			name: "error on continuation page",
+			pages: map[string]*Page[int]{
+				"": {Results: []int{1, 2}, Next: "page2"},
+			},
+			errAt:     "page2",
+			wantNexts: []string{"", "page2"},
+			wantErr:   errFetch,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			nexts := make([]string, 0)
+
+			got, err := fetchAll(func(next string) (*Page[int], error) {
+				nexts = append(nexts, next)
+
+				if next == test.errAt && test.wantErr != nil {
+					return nil, errFetch
+				}
+
+				return test.pages[next], nil
+			})
+
+			if !errors.Is(err, test.wantErr) {
+				t.Fatalf("fetchAll() error = %v, want %v", err, test.wantErr)
+			}
+
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("fetchAll() = %v, want %v", got, test.want)
+			}
+
+			if !reflect.DeepEqual(nexts, test.wantNexts) {
+				t.Errorf("continuation links = %v, want %v", nexts, test.wantNexts)
+			}
+		})
+	}
+}
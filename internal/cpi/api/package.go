@@ -1,7 +1,8 @@
 package api
 
 import (
-	"fmt"
+	"context"
+	"io"
 
 	"github.com/vadimklimov/cpi-navigator/internal/cpi/client"
 )
@@ -29,24 +30,22 @@ type ContentPackage struct {
 	ModifiedDate      int64  `json:"ModifiedDate,string"`
 }
 
-func ContentPackages() ([]ContentPackage, error) {
-	var responseBody struct {
-		Root struct {
-			Results []ContentPackage `json:"results"`
-		} `json:"d"`
-	}
+func ContentPackages(ctx context.Context) ([]ContentPackage, error) {
+	return fetchAll(func(next string) (*Page[ContentPackage], error) {
+		return ContentPackagesPage(ctx, next)
+	})
+}
 
-	res, err := client.NewClient().R().
-		SetResult(&responseBody).
-		SetQueryParam("$format", "json").
-		Get("IntegrationPackages")
-	if err != nil {
-		return nil, fmt.Errorf("error when calling %s: %w", res.Request.URL, err)
-	}
+// ContentPackagesPage fetches a single page of content packages. An empty continuation link
+// requests the first page.
+func ContentPackagesPage(ctx context.Context, next string) (*Page[ContentPackage], error) {
+	return fetchPage[ContentPackage](client.GetInstance().R(ctx), "IntegrationPackages", next)
+}
 
-	if res.IsError() {
-		return nil, fmt.Errorf("error when calling %s: %s", res.Request.URL, res.Status())
-	}
+// DownloadContentPackage streams the zip archive of the content package to the writer.
+func DownloadContentPackage(ctx context.Context, packageID string, writer io.Writer, progress Progress) error {
+	request := client.GetInstance().R(ctx).
+		SetPathParam("id", escapeKey(packageID))
 
-	return responseBody.Root.Results, nil
+	return downloadValue(request, "IntegrationPackages('{id}')/$value", writer, progress)
 }
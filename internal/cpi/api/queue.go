@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-resty/resty/v2"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/client"
+)
+
+// Queue is a JMS message queue of the runtime. The queue overflows once its size reaches the maximum,
+// after which it stops accepting messages.
+type Queue struct {
+	Name             string `json:"Name"`
+	State            string `json:"State"`
+	NumberOfMessages int    `json:"NumbOfMsgs"`
+	Size             int64  `json:"Size"`
+	MaxQueueSize     int64  `json:"MaxQueueSize"`
+	Overflow         bool   `json:"Overflow"`
+}
+
+// QueueMessage is a message waiting in a JMS queue. Messages that failed to be processed are retried
+// until their retry count is exhausted.
+type QueueMessage struct {
+	ID            string   `json:"Msgid"`
+	MessageID     string   `json:"Mplid"`
+	QueueName     string   `json:"Name"`
+	Failed        bool     `json:"Failed"`
+	FailureReason string   `json:"FailedReason"`
+	RetryCount    int      `json:"RetryCount"`
+	NextRetry     DateTime `json:"NextRetry"`
+	CreatedAt     DateTime `json:"CreatedAt"`
+}
+
+// Usage returns the size of the queue as a percentage of its maximum size, or -1 if the maximum is unknown.
+func (queue Queue) Usage() int {
+	if queue.MaxQueueSize <= 0 {
+		return -1
+	}
+
+	return int(queue.Size * 100 / queue.MaxQueueSize)
+}
+
+// Queues fetches JMS message queues of the runtime.
+func Queues(ctx context.Context) ([]Queue, error) {
+	return fetchAll(func(next string) (*Page[Queue], error) {
+		return fetchPage[Queue](client.GetInstance().R(ctx), "Queues", next)
+	})
+}
+
+// QueueMessages fetches messages of the JMS queue.
+func QueueMessages(ctx context.Context, queue Queue) ([]QueueMessage, error) {
+	return fetchAll(func(next string) (*Page[QueueMessage], error) {
+		request := client.GetInstance().R(ctx).
+			SetPathParam("name", escapeKey(queue.Name))
+
+		return fetchPage[QueueMessage](request, "Queues('{name}')/Messages", next)
+	})
+}
+
+// RetryQueueMessage makes the runtime deliver the message from its queue again right away.
+func RetryQueueMessage(ctx context.Context, message QueueMessage) error {
+	res, err := client.GetInstance().Modify(ctx, http.MethodPost, "RetryJmsMessage",
+		func(request *resty.Request) {
+			request.SetQueryParams(map[string]string{
+				"Name":  quote(message.QueueName),
+				"Msgid": quote(message.ID),
+			})
+		})
+	if err != nil {
+		return fmt.Errorf("error retrying message %s: %w", message.ID, err)
+	}
+
+	if res.IsError() {
+		return newError(res)
+	}
+
+	return nil
+}
+
+// MoveQueueMessage moves the message from its queue to the target queue.
+func MoveQueueMessage(ctx context.Context, message QueueMessage, target string) error {
+	res, err := client.GetInstance().Modify(ctx, http.MethodPost, "MoveJmsMessage",
+		func(request *resty.Request) {
+			request.SetQueryParams(map[string]string{
+				"Name":       quote(message.QueueName),
+				"Msgid":      quote(message.ID),
+				"TargetName": quote(target),
+			})
+		})
+	if err != nil {
+		return fmt.Errorf("error moving message %s to queue %s: %w", message.ID, target, err)
+	}
+
+	if res.IsError() {
+		return newError(res)
+	}
+
+	return nil
+}
+
+// DeleteQueueMessage removes the message from its queue.
+func DeleteQueueMessage(ctx context.Context, message QueueMessage) error {
+	// JMS message IDs contain colons, e.g. "ID:…", so the path is rooted to keep it from being parsed
+	// as a URL with a scheme.
+	res, err := client.GetInstance().Modify(ctx, http.MethodDelete, "/JmsMessages(Msgid='{id}',Name='{name}')",
+		func(request *resty.Request) {
+			request.SetPathParams(map[string]string{
+				"id":   escapeKey(message.ID),
+				"name": escapeKey(message.QueueName),
+			})
+		})
+	if err != nil {
+		return fmt.Errorf("error deleting message %s: %w", message.ID, err)
+	}
+
+	if res.IsError() {
+		return newError(res)
+	}
+
+	return nil
+}
@@ -0,0 +1,38 @@
+package api
+
+import (
+	"context"
+
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/client"
+)
+
+// IntegrationRuntimeArtifact is an artifact that is deployed to the tenant's runtime.
+type IntegrationRuntimeArtifact struct {
+	ID         string   `json:"Id"`
+	Version    string   `json:"Version"`
+	Name       string   `json:"Name"`
+	Type       string   `json:"Type"`
+	DeployedBy string   `json:"DeployedBy"`
+	DeployedOn DateTime `json:"DeployedOn"`
+	Status     string   `json:"Status"`
+}
+
+// Runtime statuses of deployed artifacts.
+const (
+	RuntimeStatusStarted  = "STARTED"
+	RuntimeStatusStarting = "STARTING"
+	RuntimeStatusStopping = "STOPPING"
+	RuntimeStatusError    = "ERROR"
+)
+
+func IntegrationRuntimeArtifacts(ctx context.Context) ([]IntegrationRuntimeArtifact, error) {
+	return fetchAll(func(next string) (*Page[IntegrationRuntimeArtifact], error) {
+		return IntegrationRuntimeArtifactsPage(ctx, next)
+	})
+}
+
+// IntegrationRuntimeArtifactsPage fetches a single page of artifacts deployed to the runtime.
+// An empty continuation link requests the first page.
+func IntegrationRuntimeArtifactsPage(ctx context.Context, next string) (*Page[IntegrationRuntimeArtifact], error) {
+	return fetchPage[IntegrationRuntimeArtifact](client.GetInstance().R(ctx), "IntegrationRuntimeArtifacts", next)
+}
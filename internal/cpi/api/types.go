@@ -1,9 +1,10 @@
 package api
 
 type artifactType struct {
-	Name          string
-	ResourceType  string
-	EntitySetName string
+	Name               string
+	ResourceType       string
+	EntitySetName      string
+	DeployFunctionName string
 }
 
 type ArtifactTypes struct {
@@ -21,18 +22,40 @@ func SupportedArtifactTypes() *ArtifactTypes {
 	artifactTypes.Designtime.IntegrationFlow.Name = "integration_flow"
 	artifactTypes.Designtime.IntegrationFlow.ResourceType = "integrationflows"
 	artifactTypes.Designtime.IntegrationFlow.EntitySetName = "IntegrationDesigntimeArtifacts"
+	artifactTypes.Designtime.IntegrationFlow.DeployFunctionName = "DeployIntegrationDesigntimeArtifact"
 
 	artifactTypes.Designtime.ValueMapping.Name = "value_mapping"
 	artifactTypes.Designtime.ValueMapping.ResourceType = "valuemappings"
 	artifactTypes.Designtime.ValueMapping.EntitySetName = "ValueMappingDesigntimeArtifacts"
+	artifactTypes.Designtime.ValueMapping.DeployFunctionName = "DeployValueMappingDesigntimeArtifact"
 
 	artifactTypes.Designtime.MessageMapping.Name = "message_mapping"
 	artifactTypes.Designtime.MessageMapping.ResourceType = "messagemappings"
 	artifactTypes.Designtime.MessageMapping.EntitySetName = "MessageMappingDesigntimeArtifacts"
+	artifactTypes.Designtime.MessageMapping.DeployFunctionName = "DeployMessageMappingDesigntimeArtifact"
 
 	artifactTypes.Designtime.ScriptCollection.Name = "script_collection"
 	artifactTypes.Designtime.ScriptCollection.ResourceType = "scriptcollections"
 	artifactTypes.Designtime.ScriptCollection.EntitySetName = "ScriptCollectionDesigntimeArtifacts"
+	artifactTypes.Designtime.ScriptCollection.DeployFunctionName = "DeployScriptCollectionDesigntimeArtifact"
 
 	return artifactTypes
 }
+
+// designtimeArtifactType looks up the design-time artifact type by its name.
+func designtimeArtifactType(name string) (artifactType, bool) {
+	supportedArtifactTypes := SupportedArtifactTypes()
+
+	for _, artifactType := range []artifactType{
+		supportedArtifactTypes.Designtime.IntegrationFlow,
+		supportedArtifactTypes.Designtime.ValueMapping,
+		supportedArtifactTypes.Designtime.MessageMapping,
+		supportedArtifactTypes.Designtime.ScriptCollection,
+	} {
+		if artifactType.Name == name {
+			return artifactType, true
+		}
+	}
+
+	return artifactType{}, false
+}
@@ -0,0 +1,60 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/archive"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/client"
+)
+
+// Variable is a global or a local variable written by a Write Variables step of an integration flow.
+type Variable struct {
+	VariableName    string   `json:"VariableName"`
+	IntegrationFlow string   `json:"IntegrationFlow"`
+	Visibility      string   `json:"Visibility"`
+	UpdatedAt       DateTime `json:"UpdatedAt"`
+	RetainUntil     DateTime `json:"RetainUntil"`
+}
+
+// Visibilities of variables.
+const (
+	VariableVisibilityGlobal          = "Global"
+	VariableVisibilityIntegrationFlow = "Integration Flow"
+)
+
+var errEmptyVariable = errors.New("value archive is empty")
+
+// Variables fetches global and local variables of the runtime.
+func Variables(ctx context.Context) ([]Variable, error) {
+	return fetchAll(func(next string) (*Page[Variable], error) {
+		return fetchPage[Variable](client.GetInstance().R(ctx), "Variables", next)
+	})
+}
+
+// VariableValue fetches the value of the variable. The API returns the value in a zip archive,
+// which is unpacked.
+func VariableValue(ctx context.Context, variable Variable) ([]byte, error) {
+	request := client.GetInstance().R(ctx).
+		SetPathParams(map[string]string{
+			"name": escapeKey(variable.VariableName),
+			"flow": escapeKey(variable.IntegrationFlow),
+		})
+
+	content, err := fetchValue(request, "Variables(VariableName='{name}',IntegrationFlow='{flow}')/$value")
+	if err != nil {
+		return nil, err
+	}
+
+	valueArchive, err := archive.Open(content)
+	if err != nil {
+		return nil, fmt.Errorf("error reading value of variable %s: %w", variable.VariableName, err)
+	}
+
+	if len(valueArchive.Resources) == 0 {
+		return nil, fmt.Errorf("error reading value of variable %s: %w", variable.VariableName, errEmptyVariable)
+	}
+
+	return valueArchive.Resources[0].Read()
+}
@@ -0,0 +1,172 @@
+package archive
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+)
+
+// Archive is the zip archive of a design-time integration artifact, e.g. an integration flow with its
+// BPMN model, scripts, mappings, WSDLs and parameters.
+type Archive struct {
+	Resources []Resource
+}
+
+// Resource is a file in the archive.
+type Resource struct {
+	Path string
+	Kind string
+	Size int64
+	file *zip.File
+}
+
+// Kinds of resources.
+const (
+	KindIntegrationFlow  = "Integration flow"
+	KindScript           = "Script"
+	KindMessageMapping   = "Message mapping"
+	KindOperationMapping = "Operation mapping"
+	KindXSLT             = "XSLT"
+	KindWSDL             = "WSDL"
+	KindXSD              = "XSD"
+	KindEDMX             = "EDMX"
+	KindJSON             = "JSON"
+	KindParameters       = "Parameters"
+	KindManifest         = "Manifest"
+	KindMetadata         = "Metadata"
+	KindLibrary          = "Library"
+	KindOther            = "Other"
+)
+
+// Open reads the zip archive. Resources are sorted by path, with the BPMN model of an integration flow first.
+func Open(content []byte) (*Archive, error) {
+	reader, err := zip.NewReader(bytes.NewReader(content), int64(len(content)))
+	if err != nil {
+		return nil, fmt.Errorf("error reading archive: %w", err)
+	}
+
+	resources := make([]Resource, 0, len(reader.File))
+
+	for _, file := range reader.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+
+		resources = append(resources, Resource{
+			Path: file.Name,
+			Kind: Kind(file.Name),
+			Size: int64(file.UncompressedSize64),
+			file: file,
+		})
+	}
+
+	slices.SortStableFunc(resources, func(a, b Resource) int {
+		if (a.Kind == KindIntegrationFlow) != (b.Kind == KindIntegrationFlow) {
+			if a.Kind == KindIntegrationFlow {
+				return -1
+			}
+
+			return 1
+		}
+
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return &Archive{Resources: resources}, nil
+}
+
+// Kind tells the kind of the resource by its path.
+func Kind(resourcePath string) string {
+	name := path.Base(resourcePath)
+
+	switch strings.ToLower(path.Ext(name)) {
+	case ".iflw":
+		return KindIntegrationFlow
+	case ".groovy", ".gsh", ".js":
+		return KindScript
+	case ".mmap":
+		return KindMessageMapping
+	case ".opmap":
+		return KindOperationMapping
+	case ".xsl", ".xslt":
+		return KindXSLT
+	case ".wsdl":
+		return KindWSDL
+	case ".xsd":
+		return KindXSD
+	case ".edmx":
+		return KindEDMX
+	case ".json":
+		return KindJSON
+	case ".jar":
+		return KindLibrary
+	}
+
+	switch name {
+	case "parameters.prop", "parameters.propdef":
+		return KindParameters
+	case "MANIFEST.MF":
+		return KindManifest
+	case "metainfo.prop", ".project":
+		return KindMetadata
+	}
+
+	return KindOther
+}
+
+// Read returns content of the resource.
+func (resource Resource) Read() ([]byte, error) {
+	reader, err := resource.file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", resource.Path, err)
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, fmt.Errorf("error reading %s: %w", resource.Path, err)
+	}
+
+	return content, nil
+}
+
+// Extract writes all resources to the directory, keeping their paths. Resources whose paths point
+// outside the directory are rejected.
+func (archive *Archive) Extract(dir string) error {
+	for _, resource := range archive.Resources {
+		target, err := resource.ExtractedPath(dir)
+		if err != nil {
+			return err
+		}
+
+		if err := os.MkdirAll(filepath.Dir(target), 0o750); err != nil {
+			return fmt.Errorf("error extracting %s: %w", resource.Path, err)
+		}
+
+		content, err := resource.Read()
+		if err != nil {
+			return err
+		}
+
+		if err := os.WriteFile(target, content, 0o600); err != nil {
+			return fmt.Errorf("error extracting %s: %w", resource.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// ExtractedPath returns the path the resource is extracted to in the directory.
+func (resource Resource) ExtractedPath(dir string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(resource.Path)) {
+		return "", fmt.Errorf("error extracting %s: path is outside of the archive", resource.Path)
+	}
+
+	return filepath.Join(dir, filepath.FromSlash(resource.Path)), nil
+}
@@ -1,22 +1,123 @@
 package client
 
 import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/cookiejar"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/log"
 	"github.com/go-resty/resty/v2"
 	"github.com/vadimklimov/cpi-navigator/internal/config"
-	"golang.org/x/net/context"
+	"golang.org/x/oauth2"
 	"golang.org/x/oauth2/clientcredentials"
 )
 
-func NewClient() *resty.Client {
+// Client is a long-lived client for the tenant's APIs. It shares a single pooled
+// transport and a cached OAuth token across all API calls.
+type Client struct {
+	restyClient *resty.Client
+	tokenSource oauth2.TokenSource
+	csrfToken   string
+	csrfMutex   sync.Mutex
+}
+
+const (
+	tokenEarlyExpiry    = 1 * time.Minute
+	dialTimeout         = 30 * time.Second
+	keepAlive           = 30 * time.Second
+	idleConnTimeout     = 90 * time.Second
+	tlsHandshakeTimeout = 10 * time.Second
+	maxIdleConns        = 100
+	maxIdleConnsPerHost = 10
+)
+
+var (
+	instance *Client
+	once     sync.Once
+)
+
+func GetInstance() *Client {
+	once.Do(func() {
+		instance = New()
+	})
+
+	return instance
+}
+
+func New() *Client {
+	transport := newTransport()
+
 	oauthConfig := &clientcredentials.Config{
 		TokenURL:     config.TenantTokenURL().String(),
 		ClientID:     config.TenantClientID(),
 		ClientSecret: config.TenantClientSecret(),
 	}
 
-	httpClient := oauthConfig.Client(context.Background())
+	// Token requests use the same pooled transport as API calls.
+	tokenContext := context.WithValue(context.Background(), oauth2.HTTPClient, &http.Client{
+		Transport: transport,
+		Timeout:   config.TenantTimeout(),
+	})
+
+	tokenSource := oauth2.ReuseTokenSourceWithExpiry(nil, oauthConfig.TokenSource(tokenContext), tokenEarlyExpiry)
+
+	// CSRF tokens are bound to the session cookie that is issued with them.
+	cookieJar, _ := cookiejar.New(nil)
+
+	httpClient := &http.Client{
+		Transport: &oauth2.Transport{
+			Source: tokenSource,
+			Base:   transport,
+		},
+		Jar: cookieJar,
+	}
+
 	restyClient := resty.NewWithClient(httpClient).
-		SetBaseURL(config.TenantBaseURL().String())
+		SetBaseURL(config.TenantBaseURL().String()).
+		SetLogger(log.Default()).
+		SetRetryCount(config.TenantRetryMaxRetries()).
+		SetRetryWaitTime(config.TenantRetryWaitTime()).
+		SetRetryMaxWaitTime(config.TenantRetryMaxWaitTime()).
+		SetRetryAfter(retryAfter).
+		AddRetryCondition(retryCondition).
+		AddRetryHook(retryHook)
 
-	return restyClient
+	return &Client{
+		restyClient: restyClient,
+		tokenSource: tokenSource,
+	}
+}
+
+// R creates a new request that is executed by the shared HTTP client and is bound to the context.
+func (client *Client) R(ctx context.Context) *resty.Request {
+	return client.restyClient.R().SetContext(ctx)
+}
+
+// Token returns a valid access token, fetching a new one only when the cached token has expired.
+func (client *Client) Token() (*oauth2.Token, error) {
+	return client.tokenSource.Token()
+}
+
+// newTransport creates the transport shared by all requests. The tenant timeout bounds the wait for the response
+// of each request rather than the whole exchange, so that streaming of large archives isn't cut off.
+func newTransport() *http.Transport {
+	dialer := &net.Dialer{
+		Timeout:   dialTimeout,
+		KeepAlive: keepAlive,
+	}
+
+	return &http.Transport{
+		Proxy:                 http.ProxyFromEnvironment,
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          maxIdleConns,
+		MaxIdleConnsPerHost:   maxIdleConnsPerHost,
+		IdleConnTimeout:       idleConnTimeout,
+		TLSHandshakeTimeout:   tlsHandshakeTimeout,
+		ResponseHeaderTimeout: config.TenantTimeout(),
+		ExpectContinueTimeout: 1 * time.Second,
+	}
 }
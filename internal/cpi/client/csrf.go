@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/go-resty/resty/v2"
+)
+
+const csrfTokenHeader = "X-CSRF-Token"
+
+// Modify executes a modifying request, e.g. PUT or POST, that is prepared by the callback. The request carries
+// a CSRF token, which is fetched on first use and cached. If the tenant rejects the cached token because it has
+// expired, a new token is fetched and the request is sent once again.
+func (client *Client) Modify(ctx context.Context, method, path string, prepare func(*resty.Request),
+) (*resty.Response, error) {
+	for attempt := 1; ; attempt++ {
+		token, err := client.fetchCSRFToken(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		request := client.R(ctx).SetHeader(csrfTokenHeader, token)
+		prepare(request)
+
+		res, err := request.Execute(method, path)
+		if err != nil {
+			return res, err
+		}
+
+		if attempt == 1 && res.StatusCode() == http.StatusForbidden &&
+			strings.EqualFold(res.Header().Get(csrfTokenHeader), "Required") {
+			client.resetCSRFToken(token)
+
+			continue
+		}
+
+		return res, nil
+	}
+}
+
+func (client *Client) fetchCSRFToken(ctx context.Context) (string, error) {
+	client.csrfMutex.Lock()
+	defer client.csrfMutex.Unlock()
+
+	if client.csrfToken != "" {
+		return client.csrfToken, nil
+	}
+
+	res, err := client.R(ctx).
+		SetHeader(csrfTokenHeader, "Fetch").
+		Get("/")
+	if err != nil {
+		return "", fmt.Errorf("error fetching CSRF token: %w", err)
+	}
+
+	token := res.Header().Get(csrfTokenHeader)
+	if token == "" {
+		return "", fmt.Errorf("error fetching CSRF token: %s returned no token (%s)", res.Request.URL, res.Status())
+	}
+
+	client.csrfToken = token
+
+	return token, nil
+}
+
+// resetCSRFToken discards the cached token, unless it has already been replaced by another request.
+func (client *Client) resetCSRFToken(token string) {
+	client.csrfMutex.Lock()
+	defer client.csrfMutex.Unlock()
+
+	if client.csrfToken == token {
+		client.csrfToken = ""
+	}
+}
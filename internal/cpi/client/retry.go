@@ -0,0 +1,81 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/charmbracelet/log"
+	"github.com/go-resty/resty/v2"
+)
+
+var retryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// retryCondition retries idempotent requests that failed with a transient error
+// or were throttled by the tenant.
+func retryCondition(res *resty.Response, err error) bool {
+	if res == nil || res.Request == nil {
+		return false
+	}
+
+	if res.Request.Method != http.MethodGet && res.Request.Method != http.MethodHead {
+		return false
+	}
+
+	if err != nil {
+		return !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded)
+	}
+
+	return slices.Contains(retryableStatusCodes, res.StatusCode())
+}
+
+// retryAfter honours the Retry-After header, if the tenant sent it. Zero duration
+// falls back to exponential backoff with jitter.
+func retryAfter(_ *resty.Client, res *resty.Response) (time.Duration, error) {
+	if res == nil {
+		return 0, nil
+	}
+
+	value := res.Header().Get("Retry-After")
+	if value == "" {
+		return 0, nil
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		return time.Duration(seconds) * time.Second, nil
+	}
+
+	if date, err := http.ParseTime(value); err == nil && time.Until(date) > 0 {
+		return time.Until(date), nil
+	}
+
+	return 0, nil
+}
+
+func retryHook(res *resty.Response, err error) {
+	if res == nil || res.Request == nil {
+		return
+	}
+
+	keyvals := []any{
+		"method", res.Request.Method,
+		"url", res.Request.URL,
+		"attempt", res.Request.Attempt,
+	}
+
+	if err != nil {
+		keyvals = append(keyvals, "err", err)
+	} else {
+		keyvals = append(keyvals, "status", res.Status())
+	}
+
+	log.Debug("Retrying request", keyvals...)
+}
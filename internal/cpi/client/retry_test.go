@@ -0,0 +1,160 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-resty/resty/v2"
+)
+
+func TestRetryCondition(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		statusCode int
+		err        error
+		want       bool
+	}{
+		{name: "throttled", method: http.MethodGet, statusCode: http.StatusTooManyRequests, want: true},
+		{name: "bad gateway", method: http.MethodGet, statusCode: http.StatusBadGateway, want: true},
+		{name: "service unavailable", method: http.MethodGet, statusCode: http.StatusServiceUnavailable, want: true},
+		{name: "gateway timeout", method: http.MethodGet, statusCode: http.StatusGatewayTimeout, want: true},
+		{name: "head request", method: http.MethodHead, statusCode: http.StatusServiceUnavailable, want: true},
+		{name: "success", method: http.MethodGet, statusCode: http.StatusOK, want: false},
+		{name: "not found", method: http.MethodGet, statusCode: http.StatusNotFound, want: false},
+		{name: "internal server error", method: http.MethodGet, statusCode: http.StatusInternalServerError, want: false},
+		{name: "post request", method: http.MethodPost, statusCode: http.StatusServiceUnavailable, want: false},
+		{name: "put request", method: http.MethodPut, statusCode: http.StatusTooManyRequests, want: false},
+		{name: "network error", method: http.MethodGet, err: errors.New("connection reset"), want: true},
+		{name: "network error of post request", method: http.MethodPost, err: errors.New("connection reset"), want: false},
+		{name: "cancelled", method: http.MethodGet, err: context.Canceled, want: false},
+		{name: "deadline exceeded", method: http.MethodGet, err: fmt.Errorf("get: %w", context.DeadlineExceeded), want: false},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			res := &resty.Response{
+				Request:     &resty.Request{Method: test.method},
+				RawResponse: &http.Response{StatusCode: test.statusCode},
+			}
+
+			if got := retryCondition(res, test.err); got != test.want {
+				t.Errorf("retryCondition() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRetryConditionWithoutRequest(t *testing.T) {
+	if retryCondition(nil, errors.New("connection reset")) {
+		t.Error("retryCondition() = true for a missing response, want false")
+	}
+
+	if retryCondition(&resty.Response{}, nil) {
+		t.Error("retryCondition() = true for a response without request, want false")
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   string
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{name: "missing", value: "", wantMin: 0, wantMax: 0},
+		{name: "seconds", value: "5", wantMin: 5 * time.Second, wantMax: 5 * time.Second},
+		{name: "invalid", value: "soon", wantMin: 0, wantMax: 0},
+		{
+			name:    "future date",
+			value:   time.Now().Add(30 * time.Second).UTC().Format(http.TimeFormat),
+			wantMin: 28 * time.Second,
+			wantMax: 30 * time.Second,
+		},
+		{
+			name:    "past date",
+			value:   time.Now().Add(-30 * time.Second).UTC().Format(http.TimeFormat),
+			wantMin: 0,
+			wantMax: 0,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			header := http.Header{}
+			if test.value != "" {
+				header.Set("Retry-After", test.value)
+			}
+
+			got, err := retryAfter(nil, &resty.Response{RawResponse: &http.Response{Header: header}})
+			if err != nil {
+				t.Fatalf("retryAfter() error = %v", err)
+			}
+
+			if got < test.wantMin || got > test.wantMax {
+				t.Errorf("retryAfter() = %v, want between %v and %v", got, test.wantMin, test.wantMax)
+			}
+		})
+	}
+}
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		failures     int32
+		wantStatus   int
+		wantAttempts int32
+	}{
+		{name: "get recovers", method: http.MethodGet, failures: 2, wantStatus: http.StatusOK, wantAttempts: 3},
+		{name: "get gives up", method: http.MethodGet, failures: 5, wantStatus: http.StatusServiceUnavailable,
+			wantAttempts: 4},
+		{name: "post isn't retried", method: http.MethodPost, failures: 2,
+			wantStatus: http.StatusServiceUnavailable, wantAttempts: 1},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var attempts atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				if attempts.Add(1) <= test.failures {
+					w.Header().Set("Retry-After", "0")
+					w.WriteHeader(http.StatusServiceUnavailable)
+
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client := resty.New().
+				SetBaseURL(server.URL).
+				SetRetryCount(3).
+				SetRetryWaitTime(time.Millisecond).
+				SetRetryMaxWaitTime(10 * time.Millisecond).
+				SetRetryAfter(retryAfter).
+				AddRetryCondition(retryCondition)
+
+			res, err := client.R().Execute(test.method, "/")
+			if err != nil {
+				t.Fatalf("request error = %v", err)
+			}
+
+			if res.StatusCode() != test.wantStatus {
+				t.Errorf("status = %d, want %d", res.StatusCode(), test.wantStatus)
+			}
+
+			if got := attempts.Load(); got != test.wantAttempts {
+				t.Errorf("attempts = %d, want %d", got, test.wantAttempts)
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+package iflow
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// IntegrationFlow is the process model of an integration flow, parsed from its BPMN file (.iflw).
+type IntegrationFlow struct {
+	Participants []Participant
+	Channels     []Channel
+	Processes    []Process
+}
+
+// Participant is a sender or a receiver of the integration flow, or one of its integration processes.
+type Participant struct {
+	ID        string
+	Name      string
+	Type      string
+	ProcessID string
+}
+
+// Channel connects a sender or a receiver with a step of an integration process using an adapter.
+type Channel struct {
+	ID                string
+	Name              string
+	Source            string
+	Target            string
+	AdapterType       string
+	Direction         string
+	TransportProtocol string
+	MessageProtocol   string
+}
+
+// Process is an integration process, a local integration process or an exception subprocess.
+type Process struct {
+	ID           string
+	Name         string
+	Local        bool
+	Steps        []Step
+	Flows        []SequenceFlow
+	SubProcesses []Process
+}
+
+// Step is an event, an activity or a gateway of a process.
+type Step struct {
+	ID         string
+	Name       string
+	Element    string
+	Type       string
+	Events     []string
+	Default    string
+	Properties map[string]string
+}
+
+// SequenceFlow connects two steps of a process. Routes of routers have a condition.
+type SequenceFlow struct {
+	ID        string
+	Name      string
+	Source    string
+	Target    string
+	Condition string
+}
+
+// Types of participants.
+const (
+	ParticipantSender   = "EndpointSender"
+	ParticipantReceiver = "EndpointRecevier"
+	ParticipantProcess  = "IntegrationProcess"
+)
+
+// BPMN elements of steps.
+const (
+	ElementStartEvent             = "startEvent"
+	ElementEndEvent               = "endEvent"
+	ElementIntermediateCatchEvent = "intermediateCatchEvent"
+	ElementIntermediateThrowEvent = "intermediateThrowEvent"
+	ElementExclusiveGateway       = "exclusiveGateway"
+	ElementParallelGateway        = "parallelGateway"
+	ElementSubProcess             = "subProcess"
+)
+
+// Local integration processes are called from other processes.
+const localProcessType = "directCall"
+
+var errNoProcess = errors.New("no integration process found")
+
+type definitions struct {
+	Collaboration struct {
+		Participants []participant `xml:"participant"`
+		MessageFlows []messageFlow `xml:"messageFlow"`
+	} `xml:"collaboration"`
+	Processes []process `xml:"process"`
+}
+
+type participant struct {
+	ID         string     `xml:"id,attr"`
+	Name       string     `xml:"name,attr"`
+	Type       string     `xml:"type,attr"`
+	ProcessRef string     `xml:"processRef,attr"`
+	Properties []property `xml:"extensionElements>property"`
+}
+
+type messageFlow struct {
+	ID         string     `xml:"id,attr"`
+	Name       string     `xml:"name,attr"`
+	SourceRef  string     `xml:"sourceRef,attr"`
+	TargetRef  string     `xml:"targetRef,attr"`
+	Properties []property `xml:"extensionElements>property"`
+}
+
+type property struct {
+	Key   string `xml:"key"`
+	Value string `xml:"value"`
+}
+
+type sequenceFlow struct {
+	ID                  string `xml:"id,attr"`
+	Name                string `xml:"name,attr"`
+	SourceRef           string `xml:"sourceRef,attr"`
+	TargetRef           string `xml:"targetRef,attr"`
+	ConditionExpression string `xml:"conditionExpression"`
+}
+
+// process is decoded element by element, because steps are represented by a variety of BPMN elements.
+type process struct {
+	Process
+}
+
+type step struct {
+	ID         string     `xml:"id,attr"`
+	Name       string     `xml:"name,attr"`
+	Default    string     `xml:"default,attr"`
+	Properties []property `xml:"extensionElements>property"`
+	Children   []struct {
+		XMLName xml.Name
+	} `xml:",any"`
+}
+
+// Parse parses the BPMN model of the integration flow.
+func Parse(content []byte) (*IntegrationFlow, error) {
+	var model definitions
+
+	decoder := xml.NewDecoder(bytes.NewReader(content))
+	if err := decoder.Decode(&model); err != nil {
+		return nil, fmt.Errorf("error parsing integration flow model: %w", err)
+	}
+
+	if len(model.Processes) == 0 {
+		return nil, errNoProcess
+	}
+
+	flow := &IntegrationFlow{
+		Participants: make([]Participant, 0, len(model.Collaboration.Participants)),
+		Channels:     make([]Channel, 0, len(model.Collaboration.MessageFlows)),
+		Processes:    make([]Process, 0, len(model.Processes)),
+	}
+
+	for _, participant := range model.Collaboration.Participants {
+		participantType := participant.Type
+		if participantType == "" {
+			participantType = properties(participant.Properties)["ifl:type"]
+		}
+
+		flow.Participants = append(flow.Participants, Participant{
+			ID:        participant.ID,
+			Name:      participant.Name,
+			Type:      participantType,
+			ProcessID: participant.ProcessRef,
+		})
+	}
+
+	for _, messageFlow := range model.Collaboration.MessageFlows {
+		values := properties(messageFlow.Properties)
+
+		flow.Channels = append(flow.Channels, Channel{
+			ID:                messageFlow.ID,
+			Name:              messageFlow.Name,
+			Source:            messageFlow.SourceRef,
+			Target:            messageFlow.TargetRef,
+			AdapterType:       values["ComponentType"],
+			Direction:         values["Direction"],
+			TransportProtocol: values["TransportProtocol"],
+			MessageProtocol:   values["MessageProtocol"],
+		})
+	}
+
+	// The integration process comes first, followed by local integration processes.
+	for _, process := range model.Processes {
+		if !process.Local {
+			flow.Processes = append(flow.Processes, process.Process)
+		}
+	}
+
+	for _, process := range model.Processes {
+		if process.Local {
+			flow.Processes = append(flow.Processes, process.Process)
+		}
+	}
+
+	return flow, nil
+}
+
+// UnmarshalXML decodes steps, sequence flows and subprocesses of the process.
+func (decoded *process) UnmarshalXML(decoder *xml.Decoder, start xml.StartElement) error {
+	for _, attr := range start.Attr {
+		switch attr.Name.Local {
+		case "id":
+			decoded.ID = attr.Value
+		case "name":
+			decoded.Name = attr.Value
+		}
+	}
+
+	for {
+		token, err := decoder.Token()
+		if err != nil {
+			return err
+		}
+
+		switch token := token.(type) {
+		case xml.EndElement:
+			return nil
+
+		case xml.StartElement:
+			if err := decoded.decodeElement(decoder, token); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (decoded *process) decodeElement(decoder *xml.Decoder, element xml.StartElement) error {
+	switch element.Name.Local {
+	case "extensionElements":
+		var values struct {
+			Properties []property `xml:"property"`
+		}
+
+		if err := decoder.DecodeElement(&values, &element); err != nil {
+			return err
+		}
+
+		decoded.Local = properties(values.Properties)["processType"] == localProcessType
+
+	case "sequenceFlow":
+		var flow sequenceFlow
+		if err := decoder.DecodeElement(&flow, &element); err != nil {
+			return err
+		}
+
+		decoded.Flows = append(decoded.Flows, SequenceFlow{
+			ID:        flow.ID,
+			Name:      flow.Name,
+			Source:    flow.SourceRef,
+			Target:    flow.TargetRef,
+			Condition: strings.TrimSpace(flow.ConditionExpression),
+		})
+
+	case ElementSubProcess:
+		var subProcess process
+		if err := decoder.DecodeElement(&subProcess, &element); err != nil {
+			return err
+		}
+
+		decoded.SubProcesses = append(decoded.SubProcesses, subProcess.Process)
+
+	default:
+		var child step
+		if err := decoder.DecodeElement(&child, &element); err != nil {
+			return err
+		}
+
+		decoded.Steps = append(decoded.Steps, newStep(element.Name.Local, child))
+	}
+
+	return nil
+}
+
+func newStep(element string, decoded step) Step {
+	values := properties(decoded.Properties)
+
+	step := Step{
+		ID:         decoded.ID,
+		Name:       decoded.Name,
+		Element:    element,
+		Type:       stepType(values),
+		Default:    decoded.Default,
+		Properties: values,
+	}
+
+	for _, child := range decoded.Children {
+		if name := child.XMLName.Local; strings.HasSuffix(name, "EventDefinition") {
+			step.Events = append(step.Events, strings.TrimSuffix(name, "EventDefinition"))
+		}
+	}
+
+	return step
+}
+
+// stepType returns the type of the step as it is named in the integration flow editor, e.g. GroovyScript,
+// which is more specific than the activity type, e.g. Script.
+func stepType(values map[string]string) string {
+	// E.g. ctype::FlowstepVariant/cname::GroovyScript/version::1.1.2.
+	for _, segment := range strings.Split(values["cmdVariantUri"], "/") {
+		if name, ok := strings.CutPrefix(segment, "cname::"); ok && name != "" {
+			return name
+		}
+	}
+
+	return values["activityType"]
+}
+
+func properties(list []property) map[string]string {
+	values := make(map[string]string, len(list))
+	for _, property := range list {
+		values[property.Key] = property.Value
+	}
+
+	return values
+}
+
+// Participant returns the participant with the ID, if any.
+func (flow *IntegrationFlow) Participant(id string) *Participant {
+	for idx := range flow.Participants {
+		if flow.Participants[idx].ID == id {
+			return &flow.Participants[idx]
+		}
+	}
+
+	return nil
+}
+
+// Process returns the process with the ID, if any.
+func (flow *IntegrationFlow) Process(id string) *Process {
+	for idx := range flow.Processes {
+		if flow.Processes[idx].ID == id {
+			return &flow.Processes[idx]
+		}
+	}
+
+	return nil
+}
+
+// ChannelsOf returns channels that connect the step with senders or receivers.
+func (flow *IntegrationFlow) ChannelsOf(stepID string) []Channel {
+	channels := make([]Channel, 0)
+
+	for _, channel := range flow.Channels {
+		if channel.Source == stepID || channel.Target == stepID {
+			channels = append(channels, channel)
+		}
+	}
+
+	return channels
+}
@@ -0,0 +1,211 @@
+package iflow
+
+import (
+	"errors"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func parseFixture(t *testing.T) *IntegrationFlow {
+	t.Helper()
+
+	content, err := os.ReadFile("testdata/orders.iflw")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	flow, err := Parse(content)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	return flow
+}
+
+func TestParseParticipants(t *testing.T) {
+	flow := parseFixture(t)
+
+	want := []Participant{
+		{ID: "Participant_1", Name: "S4HANA", Type: ParticipantSender},
+		{ID: "Participant_2", Name: "Salesforce", Type: ParticipantReceiver},
+		{ID: "Participant_Process_1", Name: "Integration Process", Type: ParticipantProcess, ProcessID: "Process_1"},
+		{ID: "Participant_Process_2", Name: "Enrich Order", Type: ParticipantProcess, ProcessID: "Process_2"},
+	}
+
+	if !reflect.DeepEqual(flow.Participants, want) {
+		t.Errorf("Participants = %+v, want %+v", flow.Participants, want)
+	}
+}
+
+func TestParseChannels(t *testing.T) {
+	flow := parseFixture(t)
+
+	want := []Channel{
+		{
+			ID: "MessageFlow_1", Name: "HTTPS", Source: "Participant_1", Target: "StartEvent_2",
+			AdapterType: "HTTPS", Direction: "Sender", TransportProtocol: "HTTPS", MessageProtocol: "None",
+		},
+		{
+			ID: "MessageFlow_2", Name: "HTTP", Source: "EndEvent_3", Target: "Participant_2",
+			AdapterType: "HTTP", Direction: "Receiver",
+		},
+	}
+
+	if !reflect.DeepEqual(flow.Channels, want) {
+		t.Errorf("Channels = %+v, want %+v", flow.Channels, want)
+	}
+
+	if got := flow.ChannelsOf("EndEvent_3"); len(got) != 1 || got[0].ID != "MessageFlow_2" {
+		t.Errorf("ChannelsOf(EndEvent_3) = %+v, want MessageFlow_2", got)
+	}
+}
+
+func TestParseProcesses(t *testing.T) {
+	// stepSummary leaves out properties, which are checked separately.
+	type stepSummary struct {
+		ID, Name, Element, Type, Default string
+		Events                           []string
+	}
+
+	summarize := func(steps []Step) []stepSummary {
+		summaries := make([]stepSummary, 0, len(steps))
+		for _, step := range steps {
+			summaries = append(summaries, stepSummary{
+				ID: step.ID, Name: step.Name, Element: step.Element, Type: step.Type, Default: step.Default,
+				Events: step.Events,
+			})
+		}
+
+		return summaries
+	}
+
+	tests := []struct {
+		name             string
+		process          func(flow *IntegrationFlow) Process
+		wantID           string
+		wantLocal        bool
+		wantSteps        []stepSummary
+		wantFlows        []SequenceFlow
+		wantSubProcesses int
+	}{
+		{
+			name:    "integration process comes first",
+			process: func(flow *IntegrationFlow) Process { return flow.Processes[0] },
+			wantID:  "Process_1",
+			wantSteps: []stepSummary{
+				{ID: "StartEvent_2", Name: "Start", Element: ElementStartEvent, Events: []string{"message"}},
+				{ID: "CallActivity_5", Name: "Map Order", Element: "callActivity", Type: "GroovyScript"},
+				{
+					ID: "ExclusiveGateway_6", Name: "Known Customer?", Element: ElementExclusiveGateway,
+					Type: "ExclusiveGateway", Default: "SequenceFlow_9",
+				},
+				{ID: "CallActivity_7", Name: "Enrich Order", Element: "callActivity", Type: "ProcessCallElement"},
+				{ID: "EndEvent_3", Name: "End", Element: ElementEndEvent, Events: []string{"message"}},
+			},
+			wantFlows: []SequenceFlow{
+				{ID: "SequenceFlow_3", Source: "StartEvent_2", Target: "CallActivity_5"},
+				{ID: "SequenceFlow_4", Source: "CallActivity_5", Target: "ExclusiveGateway_6"},
+				{
+					ID: "SequenceFlow_8", Name: "Known", Source: "ExclusiveGateway_6", Target: "CallActivity_7",
+					Condition: "${header.CustomerKnown} = 'true'",
+				},
+				{ID: "SequenceFlow_9", Name: "Unknown", Source: "ExclusiveGateway_6", Target: "EndEvent_3"},
+				{ID: "SequenceFlow_10", Source: "CallActivity_7", Target: "EndEvent_3"},
+			},
+			wantSubProcesses: 1,
+		},
+		{
+			name:    "exception subprocess",
+			process: func(flow *IntegrationFlow) Process { return flow.Processes[0].SubProcesses[0] },
+			wantID:  "SubProcess_11",
+			wantSteps: []stepSummary{
+				{ID: "StartEvent_12", Name: "Error Start", Element: ElementStartEvent, Events: []string{"error"}},
+				{ID: "EndEvent_13", Name: "Error End", Element: ElementEndEvent},
+			},
+			wantFlows: []SequenceFlow{
+				{ID: "SequenceFlow_14", Source: "StartEvent_12", Target: "EndEvent_13"},
+			},
+		},
+		{
+			name:      "local integration process follows",
+			process:   func(flow *IntegrationFlow) Process { return flow.Processes[1] },
+			wantID:    "Process_2",
+			wantLocal: true,
+			wantSteps: []stepSummary{
+				{ID: "StartEvent_21", Name: "Start 1", Element: ElementStartEvent},
+				{ID: "EndEvent_22", Name: "End 1", Element: ElementEndEvent},
+			},
+			wantFlows: []SequenceFlow{
+				{ID: "SequenceFlow_23", Source: "StartEvent_21", Target: "EndEvent_22"},
+			},
+		},
+	}
+
+	flow := parseFixture(t)
+
+	if len(flow.Processes) != 2 {
+		t.Fatalf("Processes = %d, want 2", len(flow.Processes))
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			process := test.process(flow)
+
+			if process.ID != test.wantID || process.Local != test.wantLocal {
+				t.Errorf("ID, Local = %s, %v, want %s, %v", process.ID, process.Local, test.wantID, test.wantLocal)
+			}
+
+			if got := summarize(process.Steps); !reflect.DeepEqual(got, test.wantSteps) {
+				t.Errorf("Steps = %+v, want %+v", got, test.wantSteps)
+			}
+
+			if !reflect.DeepEqual(process.Flows, test.wantFlows) {
+				t.Errorf("Flows = %+v, want %+v", process.Flows, test.wantFlows)
+			}
+
+			if len(process.SubProcesses) != test.wantSubProcesses {
+				t.Errorf("SubProcesses = %d, want %d", len(process.SubProcesses), test.wantSubProcesses)
+			}
+		})
+	}
+
+	if got := flow.Processes[0].Steps[1].Properties["script"]; got != "mapOrder.groovy" {
+		t.Errorf("script property = %q, want mapOrder.groovy", got)
+	}
+
+	if got := flow.Process("Process_2"); got == nil || got.Name != "Enrich Order" {
+		t.Errorf("Process(Process_2) = %+v, want Enrich Order", got)
+	}
+}
+
+func TestParseInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		content string
+		wantErr error
+	}{
+		{
+			name:    "no process",
+			content: `<definitions><collaboration id="Collaboration_1"/></definitions>`,
+			wantErr: errNoProcess,
+		},
+		{
+			name:    "malformed",
+			content: `<definitions><process id="Process_1">`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := Parse([]byte(test.content))
+			if err == nil {
+				t.Fatal("Parse() error = nil, want an error")
+			}
+
+			if test.wantErr != nil && !errors.Is(err, test.wantErr) {
+				t.Errorf("Parse() error = %v, want %v", err, test.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+)
+
+// openArchiveScreen lists resources in the archive of the artifact selected in the artifacts pane.
+func (model *Model) openArchiveScreen() []tea.Cmd {
+	if model.activePane != ArtifactsPane || model.artifacts.SelectedArtifactID() == nil {
+		return nil
+	}
+
+	model.screen = ArchiveScreen
+
+	return []tea.Cmd{
+		model.archive.Open(model.artifacts.SelectedArtifactType(),
+			*model.artifacts.SelectedArtifactID(), *model.artifacts.SelectedArtifactName()),
+	}
+}
+
+// openDiagramScreen shows the process diagram of the integration flow selected in the artifacts pane.
+func (model *Model) openDiagramScreen() []tea.Cmd {
+	if model.activePane != ArtifactsPane ||
+		model.artifacts.SelectedArtifactType() != api.SupportedArtifactTypes().Designtime.IntegrationFlow.Name ||
+		model.artifacts.SelectedArtifactID() == nil {
+		return nil
+	}
+
+	model.screen = ArchiveScreen
+
+	return []tea.Cmd{
+		model.archive.OpenDiagram(*model.artifacts.SelectedArtifactID(), *model.artifacts.SelectedArtifactName()),
+	}
+}
+
+// updateArchiveScreen handles keys of the archive screen.
+func (model *Model) updateArchiveScreen(msg tea.KeyMsg) []tea.Cmd {
+	switch {
+	case key.Matches(msg, model.common.KeyMap.Close):
+		if !model.archive.Back() {
+			model.closeScreen()
+		}
+
+	default:
+		_, cmd := model.archive.Update(msg)
+
+		return []tea.Cmd{cmd}
+	}
+
+	return nil
+}
@@ -1,11 +1,127 @@
 package err
 
-import tea "github.com/charmbracelet/bubbletea"
+import (
+	"context"
+	"errors"
+	"strings"
 
-type ErrorMsg error
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/log"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/keymap"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/styles"
+)
+
+// ErrorMsg reports a failed command. Source identifies the component whose command failed,
+// so that the component can display the error inline, and Retry re-runs the failed command.
+// Context, if any, is the context the command ran with. Once it is cancelled, the command
+// has been superseded or its screen has been closed, and it is no longer retried.
+type ErrorMsg struct {
+	Err     error
+	Source  string
+	Retry   tea.Cmd
+	Context context.Context
+}
+
+// Retryable reports whether the failed command can still be retried.
+func (msg ErrorMsg) Retryable() bool {
+	return msg.Retry != nil && (msg.Context == nil || msg.Context.Err() == nil)
+}
+
+// RetryMsg notifies the component identified by Source that its failed command is being retried.
+type RetryMsg struct {
+	Source string
+}
+
+type Detail struct {
+	Key, Value string
+}
 
 func ErrorCmd(err error) tea.Cmd {
 	return func() tea.Msg {
-		return ErrorMsg(err)
+		return ErrorMsg{Err: err}
+	}
+}
+
+func RetryCmd(source string) tea.Cmd {
+	return func() tea.Msg {
+		return RetryMsg{Source: source}
+	}
+}
+
+// Summary returns a short, single-line description of the error.
+func Summary(err error) string {
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) {
+		return err.Error()
+	}
+
+	if apiErr.Message != "" {
+		return apiErr.Status + ": " + apiErr.Message
+	}
+
+	return apiErr.Status
+}
+
+// Details breaks the error down into details to be displayed. API errors are broken down into
+// the fields returned by the tenant, other errors are represented by their message.
+func Details(err error) []Detail {
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) {
+		return []Detail{{Key: "Message", Value: err.Error()}}
+	}
+
+	details := []Detail{
+		{Key: "Status", Value: apiErr.Status},
+		{Key: "URL", Value: apiErr.URL},
+	}
+
+	if apiErr.Code != "" {
+		details = append(details, Detail{Key: "Code", Value: apiErr.Code})
+	}
+
+	if apiErr.Message != "" {
+		details = append(details, Detail{Key: "Message", Value: apiErr.Message})
+	}
+
+	if apiErr.CorrelationID != "" {
+		details = append(details, Detail{Key: "Correlation ID", Value: apiErr.CorrelationID})
+	}
+
+	return details
+}
+
+// Render renders the error as an inline error state of a pane of the given width.
+func Render(styles *styles.Styles, err error, width int) string {
+	details := Details(err)
+
+	lines := make([]string, 0, len(details))
+	for _, detail := range details {
+		lines = append(lines, detail.Key+": "+detail.Value)
+	}
+
+	return lipgloss.JoinVertical(
+		lipgloss.Center,
+		styles.Error.Title.Width(width).Render("Error"),
+		styles.Error.Details.Width(width).Render(strings.Join(lines, "\n")),
+		styles.Error.Hint.Width(width).Render("Press "+keymap.DefaultKeyMap().Retry.Help().Key+" to retry"),
+	)
+}
+
+func Log(err error) {
+	var apiErr *api.Error
+	if !errors.As(err, &apiErr) {
+		log.Error("Request failed", "err", err)
+
+		return
 	}
+
+	log.Error("Request failed",
+		"status", apiErr.StatusCode,
+		"code", apiErr.Code,
+		"message", apiErr.Message,
+		"url", apiErr.URL,
+		"correlation_id", apiErr.CorrelationID,
+	)
 }
@@ -0,0 +1,92 @@
+package filter
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
+)
+
+// Value composes the value that list items are fuzzy-matched against. The first field is the one
+// displayed in the list, so that match positions within it can be highlighted.
+func Value(fields ...string) string {
+	values := make([]string, 0, len(fields))
+
+	for _, field := range fields {
+		if field != "" {
+			values = append(values, field)
+		}
+	}
+
+	return strings.Join(values, " ")
+}
+
+// Render truncates the text to the given width and highlights characters at matched positions.
+func Render(text string, width int, matches []int, style, matchStyle lipgloss.Style) string {
+	text = truncate.StringWithTail(text, uint(max(width, 0)), "…")
+	textLength := utf8.RuneCountInString(text)
+
+	visibleMatches := make([]int, 0, len(matches))
+
+	for _, match := range matches {
+		if match < textLength {
+			visibleMatches = append(visibleMatches, match)
+		}
+	}
+
+	if len(visibleMatches) == 0 {
+		return style.Render(text)
+	}
+
+	unmatchedStyle := lipgloss.NewStyle().
+		Background(style.GetBackground()).
+		Foreground(style.GetForeground())
+
+	return style.Render(lipgloss.StyleRunes(text, visibleMatches, matchStyle.Inherit(unmatchedStyle), unmatchedStyle))
+}
+
+// Setup enables filtering in the list.
+func Setup(model *list.Model, style lipgloss.Style) {
+	model.SetFilteringEnabled(true)
+	model.SetShowFilter(false)
+	model.Styles.TitleBar = lipgloss.NewStyle()
+	model.Styles.Title = style
+	model.FilterInput.Prompt = "/"
+	model.FilterInput.PromptStyle = style
+}
+
+// SyncView shows the filter input while the filter is being edited, and the applied filter afterwards.
+func SyncView(model *list.Model) {
+	model.SetShowFilter(model.SettingFilter())
+	model.SetShowTitle(model.FilterState() == list.FilterApplied)
+	model.Title = "/" + model.FilterValue()
+}
+
+// Cmd tags matches produced by the list's filtering command with the message returned by tag,
+// so that they can be routed back to the list they belong to.
+func Cmd(cmd tea.Cmd, tag func(list.FilterMatchesMsg) tea.Msg) tea.Cmd {
+	if cmd == nil {
+		return nil
+	}
+
+	return func() tea.Msg {
+		switch msg := cmd().(type) {
+		case list.FilterMatchesMsg:
+			return tag(msg)
+
+		case tea.BatchMsg:
+			cmds := make(tea.BatchMsg, 0, len(msg))
+			for _, cmd := range msg {
+				cmds = append(cmds, Cmd(cmd, tag))
+			}
+
+			return cmds
+
+		default:
+			return msg
+		}
+	}
+}
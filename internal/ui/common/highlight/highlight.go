@@ -0,0 +1,86 @@
+package highlight
+
+import (
+	"path"
+	"strings"
+
+	"github.com/alecthomas/chroma/v2"
+	"github.com/alecthomas/chroma/v2/formatters"
+	"github.com/alecthomas/chroma/v2/lexers"
+	"github.com/alecthomas/chroma/v2/styles"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+// Matches the colour palette of the application.
+const styleName = "catppuccin-mocha"
+
+// Lexers of resources of integration artifacts whose file extensions are unknown to chroma.
+var lexerNames = map[string]string{
+	".iflw":       "XML",
+	".mmap":       "XML",
+	".opmap":      "XML",
+	".propdef":    "XML",
+	".edmx":       "XML",
+	".project":    "XML",
+	".prop":       "properties",
+	".gsh":        "Groovy",
+	"MANIFEST.MF": "properties",
+}
+
+// Lines highlights syntax of the text, choosing the language by the file name, and splits it into lines.
+// The text is returned as is if the language is unknown or the terminal doesn't support colours.
+func Lines(name, text string) []string {
+	text = strings.TrimSuffix(text, "\n")
+	lines := strings.Split(text, "\n")
+
+	formatter := formatterName()
+	if formatter == "" {
+		return lines
+	}
+
+	lexer := lexer(name)
+	if lexer == nil {
+		return lines
+	}
+
+	iterator, err := chroma.Coalesce(lexer).Tokenise(nil, text)
+	if err != nil {
+		return lines
+	}
+
+	var builder strings.Builder
+	if err := formatters.Get(formatter).Format(&builder, styles.Get(styleName), iterator); err != nil {
+		return lines
+	}
+
+	return strings.Split(builder.String(), "\n")
+}
+
+func lexer(name string) chroma.Lexer {
+	base := path.Base(name)
+
+	if lexerName, ok := lexerNames[base]; ok {
+		return lexers.Get(lexerName)
+	}
+
+	if lexerName, ok := lexerNames[strings.ToLower(path.Ext(base))]; ok {
+		return lexers.Get(lexerName)
+	}
+
+	return lexers.Match(base)
+}
+
+// formatterName returns the chroma formatter for the colour profile of the terminal, if it supports colours.
+func formatterName() string {
+	switch lipgloss.ColorProfile() {
+	case termenv.TrueColor:
+		return "terminal16m"
+	case termenv.ANSI256:
+		return "terminal256"
+	case termenv.ANSI:
+		return "terminal16"
+	default:
+		return ""
+	}
+}
@@ -3,16 +3,47 @@ package keymap
 import "github.com/charmbracelet/bubbles/key"
 
 type KeyMap struct {
-	Up      key.Binding
-	Down    key.Binding
-	Left    key.Binding
-	Right   key.Binding
-	Enter   key.Binding
-	Tab     key.Binding
-	Quit    key.Binding
-	Layout  key.Binding
-	Refresh key.Binding
-	Open    key.Binding
+	Up             key.Binding
+	Down           key.Binding
+	Left           key.Binding
+	Right          key.Binding
+	Enter          key.Binding
+	Tab            key.Binding
+	Quit           key.Binding
+	Layout         key.Binding
+	Refresh        key.Binding
+	Open           key.Binding
+	Retry          key.Binding
+	Dismiss        key.Binding
+	Filter         key.Binding
+	ClearFilter    key.Binding
+	Search         key.Binding
+	Close          key.Binding
+	Messages       key.Binding
+	TimeWindow     key.Binding
+	StatusFilter   key.Binding
+	Save           key.Binding
+	MessageSearch  key.Binding
+	GoTo           key.Binding
+	Configurations key.Binding
+	Deploy         key.Binding
+	Undeploy       key.Binding
+	Upload         key.Binding
+	NewArtifact    key.Binding
+	Browse         key.Binding
+	Edit           key.Binding
+	Diagram        key.Binding
+	Keystore       key.Binding
+	Credentials    key.Binding
+	DataStores     key.Binding
+	Delete         key.Binding
+	Variables      key.Binding
+	FlowFilter     key.Binding
+	Queues         key.Binding
+	RetryMessage   key.Binding
+	Move           key.Binding
+	Confirm        key.Binding
+	Cancel         key.Binding
 }
 
 func DefaultKeyMap() *KeyMap {
@@ -68,5 +99,160 @@ func DefaultKeyMap() *KeyMap {
 		key.WithHelp("o", "open"),
 	)
 
+	keymap.Retry = key.NewBinding(
+		key.WithKeys("R"),
+		key.WithHelp("R", "retry"),
+	)
+
+	keymap.Dismiss = key.NewBinding(
+		key.WithKeys("x"),
+		key.WithHelp("x", "dismiss"),
+	)
+
+	keymap.Filter = key.NewBinding(
+		key.WithKeys("/"),
+		key.WithHelp("/", "filter"),
+	)
+
+	keymap.ClearFilter = key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("esc", "clear filter"),
+	)
+
+	keymap.Search = key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "search"),
+	)
+
+	keymap.Close = key.NewBinding(
+		key.WithKeys("esc"),
+		key.WithHelp("esc", "close"),
+	)
+
+	keymap.Messages = key.NewBinding(
+		key.WithKeys("m"),
+		key.WithHelp("m", "messages"),
+	)
+
+	keymap.TimeWindow = key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "time window"),
+	)
+
+	keymap.StatusFilter = key.NewBinding(
+		key.WithKeys("f"),
+		key.WithHelp("f", "status filter"),
+	)
+
+	keymap.Save = key.NewBinding(
+		key.WithKeys("w"),
+		key.WithHelp("w", "save"),
+	)
+
+	keymap.MessageSearch = key.NewBinding(
+		key.WithKeys("M"),
+		key.WithHelp("M", "message search"),
+	)
+
+	keymap.GoTo = key.NewBinding(
+		key.WithKeys("g"),
+		key.WithHelp("g", "go to integration flow"),
+	)
+
+	keymap.Configurations = key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "configurations"),
+	)
+
+	keymap.Deploy = key.NewBinding(
+		key.WithKeys("d"),
+		key.WithHelp("d", "deploy"),
+	)
+
+	keymap.Undeploy = key.NewBinding(
+		key.WithKeys("u"),
+		key.WithHelp("u", "undeploy"),
+	)
+
+	keymap.Upload = key.NewBinding(
+		key.WithKeys("U"),
+		key.WithHelp("U", "upload"),
+	)
+
+	keymap.NewArtifact = key.NewBinding(
+		key.WithKeys("N"),
+		key.WithHelp("N", "new artifact"),
+	)
+
+	keymap.Browse = key.NewBinding(
+		key.WithKeys("b"),
+		key.WithHelp("b", "browse archive"),
+	)
+
+	keymap.Edit = key.NewBinding(
+		key.WithKeys("e"),
+		key.WithHelp("e", "open in editor"),
+	)
+
+	keymap.Diagram = key.NewBinding(
+		key.WithKeys("p"),
+		key.WithHelp("p", "process diagram"),
+	)
+
+	keymap.Keystore = key.NewBinding(
+		key.WithKeys("k"),
+		key.WithHelp("k", "keystore"),
+	)
+
+	keymap.Credentials = key.NewBinding(
+		key.WithKeys("C"),
+		key.WithHelp("C", "credentials"),
+	)
+
+	keymap.DataStores = key.NewBinding(
+		key.WithKeys("D"),
+		key.WithHelp("D", "data stores"),
+	)
+
+	keymap.Delete = key.NewBinding(
+		key.WithKeys("delete"),
+		key.WithHelp("del", "delete"),
+	)
+
+	keymap.Variables = key.NewBinding(
+		key.WithKeys("v"),
+		key.WithHelp("v", "variables"),
+	)
+
+	keymap.FlowFilter = key.NewBinding(
+		key.WithKeys("i"),
+		key.WithHelp("i", "integration flow filter"),
+	)
+
+	keymap.Queues = key.NewBinding(
+		key.WithKeys("J"),
+		key.WithHelp("J", "JMS queues"),
+	)
+
+	keymap.RetryMessage = key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "retry message"),
+	)
+
+	keymap.Move = key.NewBinding(
+		key.WithKeys("m"),
+		key.WithHelp("m", "move"),
+	)
+
+	keymap.Confirm = key.NewBinding(
+		key.WithKeys("y"),
+		key.WithHelp("y", "confirm"),
+	)
+
+	keymap.Cancel = key.NewBinding(
+		key.WithKeys("n", "esc"),
+		key.WithHelp("n", "cancel"),
+	)
+
 	return keymap
 }
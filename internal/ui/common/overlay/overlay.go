@@ -0,0 +1,41 @@
+package overlay
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Place renders the foreground centred over the background, keeping the background visible around it.
+func Place(background, foreground string, width, height int) string {
+	backgroundLines := strings.Split(background, "\n")
+	for len(backgroundLines) < height {
+		backgroundLines = append(backgroundLines, "")
+	}
+
+	foregroundLines := strings.Split(foreground, "\n")
+	foregroundWidth := lipgloss.Width(foreground)
+
+	x := max(0, (width-foregroundWidth)/2)
+	y := max(0, (height-len(foregroundLines))/2)
+
+	for idx, foregroundLine := range foregroundLines {
+		row := y + idx
+		if row >= len(backgroundLines) {
+			break
+		}
+
+		backgroundLine := backgroundLines[row]
+
+		left := ansi.Truncate(backgroundLine, x, "")
+		left += strings.Repeat(" ", max(0, x-ansi.StringWidth(left)))
+		foregroundLine += strings.Repeat(" ", max(0, foregroundWidth-ansi.StringWidth(foregroundLine)))
+		right := ansi.TruncateLeft(backgroundLine, x+foregroundWidth, "")
+
+		// Styles left open by the truncated background must not leak into the foreground.
+		backgroundLines[row] = left + ansi.ResetStyle + foregroundLine + ansi.ResetStyle + right
+	}
+
+	return strings.Join(backgroundLines, "\n")
+}
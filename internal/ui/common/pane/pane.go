@@ -0,0 +1,199 @@
+package pane
+
+import (
+	"context"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/styles"
+)
+
+// Loader loads the dataset of a pane in the background. A new load cancels the one that is still in progress,
+// whose result is dropped. A spinner runs while loading, and the error of a failed load is kept until the next load.
+type Loader struct {
+	styles    *styles.Styles
+	source    string
+	spinner   spinner.Model
+	loading   bool
+	startedAt time.Time
+	err       error
+	ctx       context.Context
+	cancel    context.CancelFunc
+}
+
+// NewLoader creates a loader that reports errors of failed loads with the source. The spinner is rendered
+// in the style.
+func NewLoader(source string, style lipgloss.Style) Loader {
+	return Loader{
+		styles: styles.DefaultStyles(),
+		source: source,
+		spinner: spinner.New(
+			spinner.WithSpinner(spinner.Dot),
+			spinner.WithStyle(style),
+		),
+	}
+}
+
+// Update runs the spinner and keeps the error of the failed load, or clears it once the load is retried.
+func (loader *Loader) Update(msg tea.Msg) tea.Cmd {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case spinner.TickMsg:
+		if loader.loading {
+			loader.spinner, cmd = loader.spinner.Update(msg)
+		}
+
+	case err.ErrorMsg:
+		if msg.Source == loader.source {
+			loader.loading = false
+			loader.err = msg.Err
+		}
+
+	case err.RetryMsg:
+		// The failed load is only retried if it hasn't been superseded or cancelled since.
+		if msg.Source == loader.source && loader.ctx != nil && loader.ctx.Err() == nil {
+			loader.err = nil
+			cmd = loader.startLoading()
+		}
+	}
+
+	return cmd
+}
+
+// Load runs the fetch in the background, cancelling the load that is still in progress, if any. The fetch
+// gets the context of the load and returns the message to deliver, which holds the context, so that
+// the pane can tell with Done whether the message belongs to the current load. A failed load can be retried.
+func (loader *Loader) Load(fetch func(ctx context.Context) (tea.Msg, error)) tea.Cmd {
+	loader.Cancel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	loader.ctx = ctx
+	loader.cancel = cancel
+	loader.err = nil
+
+	return tea.Batch(loader.startLoading(), loader.fetchCmd(ctx, fetch))
+}
+
+// Continue runs the fetch as the next step of the current load, e.g. to fetch the next page of the dataset.
+// Unlike Load, it keeps the current load going, and it does nothing once the load is cancelled.
+func (loader *Loader) Continue(fetch func(ctx context.Context) (tea.Msg, error)) tea.Cmd {
+	if loader.ctx == nil || loader.ctx.Err() != nil {
+		return nil
+	}
+
+	return tea.Batch(loader.startLoading(), loader.fetchCmd(loader.ctx, fetch))
+}
+
+// Current reports whether the message loaded with the context belongs to the current load, which goes on.
+func (loader *Loader) Current(ctx context.Context) bool {
+	return ctx == loader.ctx && ctx.Err() == nil
+}
+
+// Done reports whether the message loaded with the context belongs to the current load, which is then over.
+// Messages of loads that have been superseded by a newer one are to be dropped.
+func (loader *Loader) Done(ctx context.Context) bool {
+	if !loader.Current(ctx) {
+		return false
+	}
+
+	loader.loading = false
+	loader.err = nil
+
+	return true
+}
+
+// Cancel cancels the load that is still in progress, if any.
+func (loader *Loader) Cancel() {
+	if loader.cancel != nil {
+		loader.cancel()
+		loader.cancel = nil
+	}
+
+	loader.loading = false
+}
+
+// Reset cancels the load that is still in progress, if any, and clears the error of the failed load.
+func (loader *Loader) Reset() {
+	loader.Cancel()
+	loader.err = nil
+}
+
+// Loading reports whether a load is in progress.
+func (loader *Loader) Loading() bool {
+	return loader.loading
+}
+
+// Err returns the error of the failed load, if any.
+func (loader *Loader) Err() error {
+	return loader.err
+}
+
+// Elapsed returns the time since the current load started.
+func (loader *Loader) Elapsed() time.Duration {
+	return time.Since(loader.startedAt)
+}
+
+// Spinner renders the spinner, e.g. for the footer of the pane while the listed dataset is reloaded.
+func (loader *Loader) Spinner() string {
+	return loader.spinner.View()
+}
+
+// View renders the error of the failed load, or the spinner with the text while the list is still empty.
+// Otherwise, it renders the list.
+func (loader *Loader) View(dataset list.Model, text string) string {
+	switch {
+	case loader.err != nil:
+		return err.Render(loader.styles, loader.err, dataset.Width())
+	case loader.loading && len(dataset.Items()) == 0:
+		return loader.spinner.Style.Width(dataset.Width()).Render(loader.spinner.View() + " " + text)
+	default:
+		return dataset.View()
+	}
+}
+
+func (loader *Loader) fetchCmd(ctx context.Context, fetch func(ctx context.Context) (tea.Msg, error)) tea.Cmd {
+	source := loader.source
+
+	var cmd tea.Cmd
+
+	cmd = func() tea.Msg {
+		msg, e := fetch(ctx)
+
+		// The load has been superseded by a newer one.
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		if e != nil {
+			return err.ErrorMsg{Err: e, Source: source, Retry: cmd, Context: ctx}
+		}
+
+		return msg
+	}
+
+	return cmd
+}
+
+// startLoading starts the spinner, unless it is already spinning for the load that is being continued.
+func (loader *Loader) startLoading() tea.Cmd {
+	if loader.loading {
+		return nil
+	}
+
+	loader.startedAt = time.Now()
+	loader.loading = true
+
+	return loader.spinner.Tick
+}
+
+// FitList sizes the list to the width of the pane and to its height less the lines reserved for
+// the title, the header, the footer and anything else surrounding the list.
+func FitList(dataset *list.Model, width, height, reservedLines int) {
+	dataset.SetSize(width, max(1, height-reservedLines))
+	dataset.Styles.NoItems = dataset.Styles.NoItems.Width(width)
+}
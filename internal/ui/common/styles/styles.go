@@ -15,9 +15,12 @@ type Styles struct {
 		Dataset  struct {
 			Area    lipgloss.Style
 			NoItems lipgloss.Style
+			Loading lipgloss.Style
+			Filter  lipgloss.Style
 			Item    struct {
 				Normal   lipgloss.Style
 				Selected lipgloss.Style
+				Match    lipgloss.Style
 			}
 		}
 	}
@@ -36,9 +39,19 @@ type Styles struct {
 		Dataset struct {
 			Area    lipgloss.Style
 			NoItems lipgloss.Style
+			Loading lipgloss.Style
+			Filter  lipgloss.Style
 			Item    struct {
 				Normal   lipgloss.Style
 				Selected lipgloss.Style
+				Match    lipgloss.Style
+				Status   struct {
+					Started    lipgloss.Style
+					Pending    lipgloss.Style
+					Error      lipgloss.Style
+					Undeployed lipgloss.Style
+				}
+				Outdated lipgloss.Style
 			}
 		}
 	}
@@ -57,15 +70,247 @@ type Styles struct {
 	}
 
 	StatusBar struct {
+		Area         lipgloss.Style
+		Tenant       lipgloss.Style
+		Message      lipgloss.Style
+		Notification lipgloss.Style
+		Refreshed    lipgloss.Style
+		Progress     struct {
+			Done    lipgloss.Style
+			Pending lipgloss.Style
+		}
+	}
+
+	MessagesPane struct {
 		Area    lipgloss.Style
-		Tenant  lipgloss.Style
-		Message lipgloss.Style
+		Title   lipgloss.Style
+		Filters lipgloss.Style
+		Header  lipgloss.Style
+		Footer  lipgloss.Style
+		Dataset struct {
+			NoItems lipgloss.Style
+			Loading lipgloss.Style
+			Item    struct {
+				Normal   lipgloss.Style
+				Selected lipgloss.Style
+			}
+			Status struct {
+				Completed  lipgloss.Style
+				Failed     lipgloss.Style
+				Retry      lipgloss.Style
+				Processing lipgloss.Style
+				Other      lipgloss.Style
+			}
+		}
 	}
 
-	Error struct {
+	MessageDetail struct {
+		Area       lipgloss.Style
+		Title      lipgloss.Style
+		Section    lipgloss.Style
+		Label      lipgloss.Style
+		Value      lipgloss.Style
+		Header     lipgloss.Style
+		Error      lipgloss.Style
+		Empty      lipgloss.Style
+		Footer     lipgloss.Style
+		Loading    lipgloss.Style
+		Attachment struct {
+			Normal   lipgloss.Style
+			Selected lipgloss.Style
+		}
+	}
+
+	ConfigurationsPane struct {
+		Area    lipgloss.Style
+		Title   lipgloss.Style
+		Mode    lipgloss.Style
+		Header  lipgloss.Style
+		Footer  lipgloss.Style
+		Input   lipgloss.Style
+		Dataset struct {
+			NoItems lipgloss.Style
+			Loading lipgloss.Style
+			Item    struct {
+				Normal   lipgloss.Style
+				Selected lipgloss.Style
+				DataType lipgloss.Style
+			}
+		}
+	}
+
+	ArchivePane struct {
+		Area       lipgloss.Style
+		Title      lipgloss.Style
+		Header     lipgloss.Style
+		Footer     lipgloss.Style
+		LineNumber lipgloss.Style
+		Empty      lipgloss.Style
+		Dataset    struct {
+			NoItems lipgloss.Style
+			Loading lipgloss.Style
+			Item    struct {
+				Normal   lipgloss.Style
+				Selected lipgloss.Style
+				Kind     lipgloss.Style
+			}
+		}
+		Diagram struct {
+			Process     lipgloss.Style
+			Participant lipgloss.Style
+			Event       lipgloss.Style
+			Activity    lipgloss.Style
+			Gateway     lipgloss.Style
+			Type        lipgloss.Style
+			Adapter     lipgloss.Style
+			Route       lipgloss.Style
+			Connector   lipgloss.Style
+		}
+	}
+
+	KeystorePane struct {
+		Area    lipgloss.Style
+		Title   lipgloss.Style
+		Header  lipgloss.Style
+		Detail  lipgloss.Style
+		Footer  lipgloss.Style
+		Dataset struct {
+			NoItems lipgloss.Style
+			Loading lipgloss.Style
+			Item    struct {
+				Normal   lipgloss.Style
+				Selected lipgloss.Style
+				Expiring lipgloss.Style
+				Expired  lipgloss.Style
+			}
+		}
+	}
+
+	CredentialsPane struct {
 		Area    lipgloss.Style
+		Title   lipgloss.Style
+		Header  lipgloss.Style
+		Detail  lipgloss.Style
+		Footer  lipgloss.Style
+		Dataset struct {
+			NoItems lipgloss.Style
+			Loading lipgloss.Style
+			Filter  lipgloss.Style
+			Item    struct {
+				Normal   lipgloss.Style
+				Selected lipgloss.Style
+				Match    lipgloss.Style
+			}
+		}
+	}
+
+	DataStoresPane struct {
+		Area    lipgloss.Style
+		Title   lipgloss.Style
+		Header  lipgloss.Style
+		Footer  lipgloss.Style
+		Dataset struct {
+			NoItems lipgloss.Style
+			Loading lipgloss.Style
+			Item    struct {
+				Normal   lipgloss.Style
+				Selected lipgloss.Style
+				Overdue  lipgloss.Style
+			}
+		}
+	}
+
+	VariablesPane struct {
+		Area    lipgloss.Style
+		Title   lipgloss.Style
+		Header  lipgloss.Style
+		Detail  lipgloss.Style
+		Footer  lipgloss.Style
+		Dataset struct {
+			NoItems lipgloss.Style
+			Loading lipgloss.Style
+			Item    struct {
+				Normal   lipgloss.Style
+				Selected lipgloss.Style
+			}
+		}
+	}
+
+	QueuesPane struct {
+		Area    lipgloss.Style
+		Title   lipgloss.Style
+		Header  lipgloss.Style
+		Detail  lipgloss.Style
+		Footer  lipgloss.Style
+		Dataset struct {
+			NoItems lipgloss.Style
+			Loading lipgloss.Style
+			Item    struct {
+				Normal   lipgloss.Style
+				Selected lipgloss.Style
+				Warning  lipgloss.Style
+				Failed   lipgloss.Style
+			}
+		}
+	}
+
+	ConfirmDialog struct {
+		Area     lipgloss.Style
+		Title    lipgloss.Style
+		Question lipgloss.Style
+		Hint     lipgloss.Style
+	}
+
+	DownloadDialog struct {
+		Area  lipgloss.Style
+		Title lipgloss.Style
+		Label lipgloss.Style
+		Input lipgloss.Style
+		Error lipgloss.Style
+		Hint  lipgloss.Style
+	}
+
+	UploadDialog struct {
+		Area        lipgloss.Style
+		Title       lipgloss.Style
+		Label       lipgloss.Style
+		Focused     lipgloss.Style
+		Input       lipgloss.Style
+		Placeholder lipgloss.Style
+		Error       lipgloss.Style
+		Hint        lipgloss.Style
+	}
+
+	MessageSearch struct {
+		Area        lipgloss.Style
+		Title       lipgloss.Style
+		Label       lipgloss.Style
+		Focused     lipgloss.Style
+		Input       lipgloss.Style
+		Placeholder lipgloss.Style
+		Error       lipgloss.Style
+		Hint        lipgloss.Style
+	}
+
+	SearchPalette struct {
+		Area    lipgloss.Style
+		Title   lipgloss.Style
+		Input   lipgloss.Style
+		NoItems lipgloss.Style
+		Status  lipgloss.Style
+		Item    struct {
+			Normal   lipgloss.Style
+			Selected lipgloss.Style
+			Match    lipgloss.Style
+			Type     lipgloss.Style
+			Package  lipgloss.Style
+		}
+	}
+
+	Error struct {
 		Title   lipgloss.Style
 		Details lipgloss.Style
+		Hint    lipgloss.Style
 	}
 }
 
@@ -77,7 +322,6 @@ func DefaultStyles() *Styles {
 		AttributesPaneWidth           = 152
 		TitleBarWidth                 = 154
 		StatusBarWidth                = 154
-		ErrorMessageWidth             = 100
 	)
 
 	colours := DefaultColours()
@@ -146,6 +390,16 @@ func DefaultStyles() *Styles {
 		Foreground(colours.Overlay0).
 		AlignHorizontal(lipgloss.Center)
 
+	styles.ContentPackagesPane.Dataset.Loading = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Width(ContentPackagesPaneWidth).
+		Foreground(colours.Teal).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.ContentPackagesPane.Dataset.Filter = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Teal)
+
 	styles.ContentPackagesPane.Dataset.Item.Normal = lipgloss.NewStyle().
 		Inherit(baseCommonStyle).
 		Width(ContentPackagesPaneWidth).
@@ -156,6 +410,10 @@ func DefaultStyles() *Styles {
 		Background(colours.Green).
 		Foreground(colours.Crust)
 
+	styles.ContentPackagesPane.Dataset.Item.Match = lipgloss.NewStyle().
+		Bold(true).
+		Underline(true)
+
 	styles.IntegrationArtifactsPane.Inactive = lipgloss.NewStyle().
 		Inherit(baseBorderStyle).
 		Width(IntegrationArtifactsPaneWidth).
@@ -198,6 +456,16 @@ func DefaultStyles() *Styles {
 		Foreground(colours.Overlay0).
 		AlignHorizontal(lipgloss.Center)
 
+	styles.IntegrationArtifactsPane.Dataset.Loading = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Width(IntegrationArtifactsPaneWidth).
+		Foreground(colours.Sky).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.IntegrationArtifactsPane.Dataset.Filter = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Sky)
+
 	styles.IntegrationArtifactsPane.Dataset.Item.Normal = lipgloss.NewStyle().
 		Inherit(baseCommonStyle).
 		Width(IntegrationArtifactsPaneWidth).
@@ -208,6 +476,30 @@ func DefaultStyles() *Styles {
 		Background(colours.Peach).
 		Foreground(colours.Crust)
 
+	styles.IntegrationArtifactsPane.Dataset.Item.Match = lipgloss.NewStyle().
+		Bold(true).
+		Underline(true)
+
+	styles.IntegrationArtifactsPane.Dataset.Item.Status.Started = lipgloss.NewStyle().
+		Foreground(colours.Green).
+		SetString("●")
+
+	styles.IntegrationArtifactsPane.Dataset.Item.Status.Pending = lipgloss.NewStyle().
+		Foreground(colours.Yellow).
+		SetString("●")
+
+	styles.IntegrationArtifactsPane.Dataset.Item.Status.Error = lipgloss.NewStyle().
+		Foreground(colours.Red).
+		SetString("●")
+
+	styles.IntegrationArtifactsPane.Dataset.Item.Status.Undeployed = lipgloss.NewStyle().
+		Foreground(colours.Overlay0).
+		SetString("○")
+
+	styles.IntegrationArtifactsPane.Dataset.Item.Outdated = lipgloss.NewStyle().
+		Foreground(colours.Peach).
+		SetString("*")
+
 	styles.AttributesPane.Area = lipgloss.NewStyle().
 		Inherit(baseBorderStyle).
 		Width(AttributesPaneWidth).
@@ -258,15 +550,651 @@ func DefaultStyles() *Styles {
 		Background(colours.Surface0).
 		AlignHorizontal(lipgloss.Left)
 
-	styles.Error.Area = lipgloss.NewStyle().
+	styles.StatusBar.Notification = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Padding(0, 1).
+		Background(colours.Red).
+		Foreground(colours.Crust).
+		AlignHorizontal(lipgloss.Left)
+
+	styles.StatusBar.Refreshed = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Padding(0, 1).
+		Background(colours.Surface1).
+		Foreground(colours.Subtext0).
+		AlignHorizontal(lipgloss.Right)
+
+	styles.StatusBar.Progress.Done = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Background(colours.Surface0).
+		Foreground(colours.Teal)
+
+	styles.StatusBar.Progress.Pending = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Background(colours.Surface0).
+		Foreground(colours.Overlay0)
+
+	styles.MessagesPane.Area = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		BorderForeground(colours.Lavender)
+
+	styles.MessagesPane.Title = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		Foreground(colours.Mauve).
+		Border(lipgloss.NormalBorder(), false, false, true, false).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.MessagesPane.Filters = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Subtext0)
+
+	styles.MessagesPane.Header = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Blue).
+		Bold(true)
+
+	styles.MessagesPane.Footer = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0)
+
+	styles.MessagesPane.Dataset.NoItems = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.MessagesPane.Dataset.Loading = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Mauve).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.MessagesPane.Dataset.Item.Normal = lipgloss.NewStyle().
+		Inherit(baseCommonStyle)
+
+	styles.MessagesPane.Dataset.Item.Selected = lipgloss.NewStyle().
+		Inherit(styles.MessagesPane.Dataset.Item.Normal).
+		Background(colours.Mauve).
+		Foreground(colours.Crust)
+
+	styles.MessagesPane.Dataset.Status.Completed = lipgloss.NewStyle().
+		Foreground(colours.Green)
+
+	styles.MessagesPane.Dataset.Status.Failed = lipgloss.NewStyle().
+		Foreground(colours.Red)
+
+	styles.MessagesPane.Dataset.Status.Retry = lipgloss.NewStyle().
+		Foreground(colours.Yellow)
+
+	styles.MessagesPane.Dataset.Status.Processing = lipgloss.NewStyle().
+		Foreground(colours.Blue)
+
+	styles.MessagesPane.Dataset.Status.Other = lipgloss.NewStyle().
+		Foreground(colours.Overlay1)
+
+	styles.MessageDetail.Area = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		BorderForeground(colours.Lavender)
+
+	styles.MessageDetail.Title = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		Foreground(colours.Mauve).
+		Border(lipgloss.NormalBorder(), false, false, true, false).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.MessageDetail.Section = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Mauve).
+		Bold(true).
+		MarginTop(1)
+
+	styles.MessageDetail.Label = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Subtext0)
+
+	styles.MessageDetail.Value = lipgloss.NewStyle().
+		Inherit(baseCommonStyle)
+
+	styles.MessageDetail.Header = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Blue).
+		Bold(true)
+
+	styles.MessageDetail.Error = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Red)
+
+	styles.MessageDetail.Empty = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0)
+
+	styles.MessageDetail.Footer = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0)
+
+	styles.MessageDetail.Loading = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Mauve)
+
+	styles.MessageDetail.Attachment.Normal = lipgloss.NewStyle().
+		Inherit(baseCommonStyle)
+
+	styles.MessageDetail.Attachment.Selected = lipgloss.NewStyle().
+		Inherit(styles.MessageDetail.Attachment.Normal).
+		Background(colours.Mauve).
+		Foreground(colours.Crust)
+
+	styles.ConfigurationsPane.Area = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		BorderForeground(colours.Lavender)
+
+	styles.ConfigurationsPane.Title = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		Foreground(colours.Teal).
+		Border(lipgloss.NormalBorder(), false, false, true, false).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.ConfigurationsPane.Mode = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Subtext0)
+
+	styles.ConfigurationsPane.Header = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Blue).
+		Bold(true)
+
+	styles.ConfigurationsPane.Footer = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0)
+
+	styles.ConfigurationsPane.Input = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Teal)
+
+	styles.ConfigurationsPane.Dataset.NoItems = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.ConfigurationsPane.Dataset.Loading = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Teal).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.ConfigurationsPane.Dataset.Item.Normal = lipgloss.NewStyle().
+		Inherit(baseCommonStyle)
+
+	styles.ConfigurationsPane.Dataset.Item.Selected = lipgloss.NewStyle().
+		Inherit(styles.ConfigurationsPane.Dataset.Item.Normal).
+		Background(colours.Teal).
+		Foreground(colours.Crust)
+
+	styles.ConfigurationsPane.Dataset.Item.DataType = lipgloss.NewStyle().
+		Foreground(colours.Overlay1)
+
+	styles.ArchivePane.Area = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		BorderForeground(colours.Lavender)
+
+	styles.ArchivePane.Title = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		Foreground(colours.Sapphire).
+		Border(lipgloss.NormalBorder(), false, false, true, false).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.ArchivePane.Header = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Blue).
+		Bold(true)
+
+	styles.ArchivePane.Footer = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0)
+
+	styles.ArchivePane.LineNumber = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0).
+		AlignHorizontal(lipgloss.Right)
+
+	styles.ArchivePane.Empty = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0)
+
+	styles.ArchivePane.Dataset.NoItems = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.ArchivePane.Dataset.Loading = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Sapphire).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.ArchivePane.Dataset.Item.Normal = lipgloss.NewStyle().
+		Inherit(baseCommonStyle)
+
+	styles.ArchivePane.Dataset.Item.Selected = lipgloss.NewStyle().
+		Inherit(styles.ArchivePane.Dataset.Item.Normal).
+		Background(colours.Sapphire).
+		Foreground(colours.Crust)
+
+	styles.ArchivePane.Dataset.Item.Kind = lipgloss.NewStyle().
+		Foreground(colours.Overlay1)
+
+	styles.ArchivePane.Diagram.Process = lipgloss.NewStyle().
+		Foreground(colours.Sapphire).
+		Bold(true)
+
+	styles.ArchivePane.Diagram.Participant = lipgloss.NewStyle().
+		Foreground(colours.Mauve)
+
+	styles.ArchivePane.Diagram.Event = lipgloss.NewStyle().
+		Foreground(colours.Green)
+
+	styles.ArchivePane.Diagram.Activity = lipgloss.NewStyle().
+		Foreground(colours.Text)
+
+	styles.ArchivePane.Diagram.Gateway = lipgloss.NewStyle().
+		Foreground(colours.Yellow)
+
+	styles.ArchivePane.Diagram.Type = lipgloss.NewStyle().
+		Foreground(colours.Overlay1)
+
+	styles.ArchivePane.Diagram.Adapter = lipgloss.NewStyle().
+		Foreground(colours.Peach)
+
+	styles.ArchivePane.Diagram.Route = lipgloss.NewStyle().
+		Foreground(colours.Sky)
+
+	styles.ArchivePane.Diagram.Connector = lipgloss.NewStyle().
+		Foreground(colours.Overlay0)
+
+	styles.KeystorePane.Area = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		BorderForeground(colours.Lavender)
+
+	styles.KeystorePane.Title = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		Foreground(colours.Flamingo).
+		Border(lipgloss.NormalBorder(), false, false, true, false).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.KeystorePane.Header = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Blue).
+		Bold(true)
+
+	styles.KeystorePane.Detail = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Subtext0)
+
+	styles.KeystorePane.Footer = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0)
+
+	styles.KeystorePane.Dataset.NoItems = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.KeystorePane.Dataset.Loading = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Flamingo).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.KeystorePane.Dataset.Item.Normal = lipgloss.NewStyle().
+		Inherit(baseCommonStyle)
+
+	styles.KeystorePane.Dataset.Item.Selected = lipgloss.NewStyle().
+		Inherit(styles.KeystorePane.Dataset.Item.Normal).
+		Background(colours.Flamingo).
+		Foreground(colours.Crust)
+
+	styles.KeystorePane.Dataset.Item.Expiring = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Yellow)
+
+	styles.KeystorePane.Dataset.Item.Expired = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Red)
+
+	styles.CredentialsPane.Area = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		BorderForeground(colours.Lavender)
+
+	styles.CredentialsPane.Title = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		Foreground(colours.Maroon).
+		Border(lipgloss.NormalBorder(), false, false, true, false).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.CredentialsPane.Header = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Blue).
+		Bold(true)
+
+	styles.CredentialsPane.Detail = lipgloss.NewStyle().
+		Inherit(baseCommonStyle)
+
+	styles.CredentialsPane.Footer = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0)
+
+	styles.CredentialsPane.Dataset.NoItems = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.CredentialsPane.Dataset.Loading = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Maroon).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.CredentialsPane.Dataset.Filter = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Maroon)
+
+	styles.CredentialsPane.Dataset.Item.Normal = lipgloss.NewStyle().
+		Inherit(baseCommonStyle)
+
+	styles.CredentialsPane.Dataset.Item.Selected = lipgloss.NewStyle().
+		Inherit(styles.CredentialsPane.Dataset.Item.Normal).
+		Background(colours.Maroon).
+		Foreground(colours.Crust)
+
+	styles.CredentialsPane.Dataset.Item.Match = lipgloss.NewStyle().
+		Bold(true).
+		Underline(true)
+
+	styles.DataStoresPane.Area = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		BorderForeground(colours.Lavender)
+
+	styles.DataStoresPane.Title = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		Foreground(colours.Peach).
+		Border(lipgloss.NormalBorder(), false, false, true, false).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.DataStoresPane.Header = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Blue).
+		Bold(true)
+
+	styles.DataStoresPane.Footer = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0)
+
+	styles.DataStoresPane.Dataset.NoItems = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.DataStoresPane.Dataset.Loading = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Peach).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.DataStoresPane.Dataset.Item.Normal = lipgloss.NewStyle().
+		Inherit(baseCommonStyle)
+
+	styles.DataStoresPane.Dataset.Item.Selected = lipgloss.NewStyle().
+		Inherit(styles.DataStoresPane.Dataset.Item.Normal).
+		Background(colours.Peach).
+		Foreground(colours.Crust)
+
+	styles.DataStoresPane.Dataset.Item.Overdue = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Yellow)
+
+	styles.VariablesPane.Area = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		BorderForeground(colours.Lavender)
+
+	styles.VariablesPane.Title = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		Foreground(colours.Sky).
+		Border(lipgloss.NormalBorder(), false, false, true, false).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.VariablesPane.Header = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Blue).
+		Bold(true)
+
+	styles.VariablesPane.Detail = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Subtext0)
+
+	styles.VariablesPane.Footer = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0)
+
+	styles.VariablesPane.Dataset.NoItems = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.VariablesPane.Dataset.Loading = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Sky).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.VariablesPane.Dataset.Item.Normal = lipgloss.NewStyle().
+		Inherit(baseCommonStyle)
+
+	styles.VariablesPane.Dataset.Item.Selected = lipgloss.NewStyle().
+		Inherit(styles.VariablesPane.Dataset.Item.Normal).
+		Background(colours.Sky).
+		Foreground(colours.Crust)
+
+	styles.QueuesPane.Area = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		BorderForeground(colours.Lavender)
+
+	styles.QueuesPane.Title = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		Foreground(colours.Teal).
+		Border(lipgloss.NormalBorder(), false, false, true, false).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.QueuesPane.Header = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Blue).
+		Bold(true)
+
+	styles.QueuesPane.Detail = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Subtext0)
+
+	styles.QueuesPane.Footer = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0)
+
+	styles.QueuesPane.Dataset.NoItems = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.QueuesPane.Dataset.Loading = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Teal).
+		AlignHorizontal(lipgloss.Center)
+
+	styles.QueuesPane.Dataset.Item.Normal = lipgloss.NewStyle().
+		Inherit(baseCommonStyle)
+
+	styles.QueuesPane.Dataset.Item.Selected = lipgloss.NewStyle().
+		Inherit(styles.QueuesPane.Dataset.Item.Normal).
+		Background(colours.Teal).
+		Foreground(colours.Crust)
+
+	styles.QueuesPane.Dataset.Item.Warning = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Yellow)
+
+	styles.QueuesPane.Dataset.Item.Failed = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Red)
+
+	styles.ConfirmDialog.Area = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		Border(lipgloss.RoundedBorder(), true).
+		BorderForeground(colours.Peach).
+		Padding(0, 1)
+
+	styles.ConfirmDialog.Title = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Peach).
+		Bold(true)
+
+	styles.ConfirmDialog.Question = lipgloss.NewStyle().
+		Inherit(baseCommonStyle)
+
+	styles.ConfirmDialog.Hint = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay1)
+
+	styles.DownloadDialog.Area = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		Border(lipgloss.RoundedBorder(), true).
+		BorderForeground(colours.Teal).
+		Padding(0, 1)
+
+	styles.DownloadDialog.Title = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Teal).
+		Bold(true)
+
+	styles.DownloadDialog.Label = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Subtext0)
+
+	styles.DownloadDialog.Input = lipgloss.NewStyle().
+		Inherit(baseCommonStyle)
+
+	styles.DownloadDialog.Error = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Red)
+
+	styles.DownloadDialog.Hint = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay1)
+
+	styles.UploadDialog.Area = lipgloss.NewStyle().
 		Inherit(baseBorderStyle).
-		Width(ErrorMessageWidth).
 		Border(lipgloss.RoundedBorder(), true).
-		BorderForeground(colours.Red)
+		BorderForeground(colours.Sky).
+		Padding(0, 1)
+
+	styles.UploadDialog.Title = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Sky).
+		Bold(true)
+
+	styles.UploadDialog.Label = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Subtext0)
+
+	styles.UploadDialog.Focused = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Sky).
+		Bold(true)
+
+	styles.UploadDialog.Input = lipgloss.NewStyle().
+		Inherit(baseCommonStyle)
+
+	styles.UploadDialog.Placeholder = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0)
+
+	styles.UploadDialog.Error = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Red)
+
+	styles.UploadDialog.Hint = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay1)
+
+	styles.MessageSearch.Area = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		Border(lipgloss.RoundedBorder(), true).
+		BorderForeground(colours.Mauve).
+		Padding(0, 1)
+
+	styles.MessageSearch.Title = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Mauve).
+		Bold(true)
+
+	styles.MessageSearch.Label = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Subtext0)
+
+	styles.MessageSearch.Focused = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Mauve).
+		Bold(true)
+
+	styles.MessageSearch.Input = lipgloss.NewStyle().
+		Inherit(baseCommonStyle)
+
+	styles.MessageSearch.Placeholder = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0)
+
+	styles.MessageSearch.Error = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Red)
+
+	styles.MessageSearch.Hint = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay1)
+
+	styles.SearchPalette.Area = lipgloss.NewStyle().
+		Inherit(baseBorderStyle).
+		Border(lipgloss.RoundedBorder(), true).
+		BorderForeground(colours.Lavender).
+		Padding(0, 1)
+
+	styles.SearchPalette.Title = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Lavender).
+		Bold(true)
+
+	styles.SearchPalette.Input = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Lavender)
+
+	styles.SearchPalette.NoItems = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay0)
+
+	styles.SearchPalette.Status = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		Foreground(colours.Overlay1)
+
+	styles.SearchPalette.Item.Normal = lipgloss.NewStyle().
+		Inherit(baseCommonStyle)
+
+	styles.SearchPalette.Item.Selected = lipgloss.NewStyle().
+		Inherit(styles.SearchPalette.Item.Normal).
+		Background(colours.Lavender).
+		Foreground(colours.Crust)
+
+	styles.SearchPalette.Item.Match = lipgloss.NewStyle().
+		Bold(true).
+		Underline(true)
+
+	styles.SearchPalette.Item.Type = lipgloss.NewStyle().
+		Width(4).
+		Foreground(colours.Sky)
+
+	styles.SearchPalette.Item.Package = lipgloss.NewStyle().
+		Foreground(colours.Teal).
+		AlignHorizontal(lipgloss.Right)
 
 	styles.Error.Title = lipgloss.NewStyle().
 		Inherit(baseCommonStyle).
-		Width(ErrorMessageWidth).
+		MarginTop(1).
 		MarginBottom(1).
 		Background(colours.Red).
 		Foreground(colours.Crust).
@@ -274,12 +1202,15 @@ func DefaultStyles() *Styles {
 
 	styles.Error.Details = lipgloss.NewStyle().
 		Inherit(baseCommonStyle).
-		Width(ErrorMessageWidth).
-		Height(5).
 		Padding(0, 1).
 		Foreground(colours.Red).
-		AlignHorizontal(lipgloss.Left).
-		AlignVertical(lipgloss.Center)
+		AlignHorizontal(lipgloss.Left)
+
+	styles.Error.Hint = lipgloss.NewStyle().
+		Inherit(baseCommonStyle).
+		MarginTop(1).
+		Foreground(colours.Overlay0).
+		AlignHorizontal(lipgloss.Center)
 
 	return styles
 }
@@ -0,0 +1,383 @@
+package resource
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/iflow"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/styles"
+)
+
+// diagram draws processes of an integration flow top down as boxes connected with arrows. Branches of
+// routers and multicasts are drawn one after another, indented under the gateway, and a step that has
+// already been drawn, e.g. where branches join, is referred to by its name.
+type diagram struct {
+	flow     *iflow.IntegrationFlow
+	styles   *styles.Styles
+	lines    []string
+	drawn    map[string]bool
+	steps    map[string]iflow.Step
+	incoming map[string]int
+	outgoing map[string][]iflow.SequenceFlow
+}
+
+// shape is the box of a participant or a step. Its connector joins the bottom edge with the arrow
+// to the next step.
+type shape struct {
+	border    lipgloss.Border
+	connector string
+	style     lipgloss.Style
+}
+
+// The arrow leaves a box at this column, so that boxes of any width stay connected.
+const (
+	arrowIndent = "  "
+	minBoxWidth = 4
+)
+
+// diagramLines draws the integration flow.
+func diagramLines(flow *iflow.IntegrationFlow, styles *styles.Styles) []string {
+	diagram := &diagram{
+		flow:   flow,
+		styles: styles,
+		lines:  make([]string, 0),
+		drawn:  make(map[string]bool),
+	}
+
+	for idx, process := range flow.Processes {
+		if idx > 0 {
+			diagram.lines = append(diagram.lines, "")
+		}
+
+		title := process.Name
+		if process.Local {
+			title += diagram.styles.ArchivePane.Diagram.Type.Render(" · local integration process")
+		}
+
+		diagram.process(process, "", title)
+	}
+
+	return diagram.lines
+}
+
+// process draws steps of the process, starting with its start events, followed by its subprocesses.
+func (diagram *diagram) process(process iflow.Process, prefix, title string) {
+	styles := diagram.styles.ArchivePane.Diagram
+
+	diagram.add(prefix, styles.Process.Render(title))
+	diagram.add(prefix, "")
+
+	diagram.steps = make(map[string]iflow.Step, len(process.Steps))
+	diagram.incoming = make(map[string]int, len(process.Steps))
+	diagram.outgoing = make(map[string][]iflow.SequenceFlow, len(process.Steps))
+
+	for _, step := range process.Steps {
+		diagram.steps[step.ID] = step
+	}
+
+	for _, flow := range process.Flows {
+		diagram.incoming[flow.Target]++
+		diagram.outgoing[flow.Source] = append(diagram.outgoing[flow.Source], flow)
+	}
+
+	// Default routes are drawn last.
+	for _, step := range process.Steps {
+		if step.Default != "" {
+			slices.SortStableFunc(diagram.outgoing[step.ID], func(a, b iflow.SequenceFlow) int {
+				return boolToInt(a.ID == step.Default) - boolToInt(b.ID == step.Default)
+			})
+		}
+	}
+
+	// Steps that are not reached from start events, e.g. unconnected ones, are drawn after them.
+	roots := make([]iflow.Step, 0)
+	for _, step := range process.Steps {
+		if step.Element == iflow.ElementStartEvent {
+			roots = append(roots, step)
+		}
+	}
+
+	for _, step := range process.Steps {
+		if step.Element != iflow.ElementStartEvent && diagram.incoming[step.ID] == 0 {
+			roots = append(roots, step)
+		}
+	}
+
+	roots = append(roots, process.Steps...)
+
+	first := true
+
+	for _, root := range roots {
+		if diagram.drawn[root.ID] {
+			continue
+		}
+
+		if !first {
+			diagram.add(prefix, "")
+		}
+
+		first = false
+
+		diagram.walk(root.ID, prefix)
+	}
+
+	for _, subProcess := range process.SubProcesses {
+		diagram.add(prefix, "")
+		diagram.process(subProcess, prefix+arrowIndent, subProcess.Name+styles.Type.Render(" · subprocess"))
+	}
+}
+
+// walk draws the step and the steps that follow it.
+func (diagram *diagram) walk(id, prefix string) {
+	styles := diagram.styles.ArchivePane.Diagram
+
+	for {
+		step, ok := diagram.steps[id]
+		if !ok {
+			return
+		}
+
+		if diagram.drawn[id] {
+			diagram.add(prefix, styles.Connector.Render(arrowIndent+"↪ ")+styles.Route.Render(stepName(step)))
+
+			return
+		}
+
+		diagram.drawn[id] = true
+		diagram.senders(step, prefix)
+
+		flows := diagram.outgoing[id]
+		diagram.box(prefix, diagram.shape(step), stepName(step), diagram.notes(step), len(flows) > 0)
+
+		switch len(flows) {
+		case 0:
+			return
+
+		case 1:
+			arrow := styles.Connector.Render(arrowIndent + "▼")
+			if flows[0].Name != "" {
+				arrow += " " + styles.Route.Render(flows[0].Name)
+			}
+
+			diagram.add(prefix, arrow)
+			id = flows[0].Target
+
+		default:
+			for idx, flow := range flows {
+				branch, indent := "├─▶ ", "│ "
+				if idx == len(flows)-1 {
+					branch, indent = "└─▶ ", "  "
+				}
+
+				diagram.add(prefix, styles.Connector.Render(arrowIndent+branch)+diagram.route(step, flow, idx))
+				diagram.walk(flow.Target, prefix+arrowIndent+indent)
+			}
+
+			return
+		}
+	}
+}
+
+// senders draws senders whose channels start the step, e.g. a message start event.
+func (diagram *diagram) senders(step iflow.Step, prefix string) {
+	styles := diagram.styles.ArchivePane.Diagram
+
+	for _, channel := range diagram.flow.ChannelsOf(step.ID) {
+		participant := diagram.flow.Participant(channel.Source)
+		if participant == nil || participant.Type != iflow.ParticipantSender {
+			continue
+		}
+
+		diagram.box(prefix, shape{
+			border:    lipgloss.DoubleBorder(),
+			connector: "╤",
+			style:     styles.Participant,
+		}, participantName(*participant), []string{styles.Type.Render("Sender")}, true)
+		diagram.add(prefix, styles.Connector.Render(arrowIndent+"│ ")+styles.Adapter.Render(adapter(channel)))
+		diagram.add(prefix, styles.Connector.Render(arrowIndent+"▼"))
+	}
+}
+
+// box draws the text in a box, followed by notes on its middle line.
+func (diagram *diagram) box(prefix string, shape shape, text string, notes []string, connected bool) {
+	border := shape.border
+	width := max(minBoxWidth, ansi.StringWidth(text)+2)
+
+	bottom := strings.Repeat(border.Bottom, width)
+	if connected {
+		bottom = border.Bottom + shape.connector + strings.Repeat(border.Bottom, width-2)
+	}
+
+	middle := shape.style.Render(border.Left+" ") + shape.style.Bold(true).Render(text) +
+		shape.style.Render(strings.Repeat(" ", width-ansi.StringWidth(text)-1)+border.Right)
+	if len(notes) > 0 {
+		middle += "  " + strings.Join(notes, "  ")
+	}
+
+	diagram.add(prefix, shape.style.Render(border.TopLeft+strings.Repeat(border.Top, width)+border.TopRight))
+	diagram.add(prefix, middle)
+	diagram.add(prefix, shape.style.Render(border.BottomLeft+bottom+border.BottomRight))
+}
+
+// notes describe the type of the step, the local process it calls and the receivers it connects to.
+func (diagram *diagram) notes(step iflow.Step) []string {
+	styles := diagram.styles.ArchivePane.Diagram
+	notes := []string{styles.Type.Render(stepType(step))}
+
+	if processID := step.Properties["processId"]; processID != "" {
+		if process := diagram.flow.Process(processID); process != nil {
+			notes = append(notes, styles.Connector.Render("→ calls ")+styles.Route.Render(process.Name))
+		}
+	}
+
+	for _, channel := range diagram.flow.ChannelsOf(step.ID) {
+		switch {
+		case channel.Source == step.ID:
+			participant := diagram.flow.Participant(channel.Target)
+			if participant == nil {
+				continue
+			}
+
+			notes = append(notes, styles.Connector.Render("──")+styles.Adapter.Render(adapter(channel))+
+				styles.Connector.Render("──▶ ")+styles.Participant.Render(participantName(*participant)))
+
+		case channel.Target == step.ID:
+			participant := diagram.flow.Participant(channel.Source)
+			if participant == nil || participant.Type == iflow.ParticipantSender {
+				continue
+			}
+
+			notes = append(notes, styles.Connector.Render("◀──")+styles.Adapter.Render(adapter(channel))+
+				styles.Connector.Render("── ")+styles.Participant.Render(participantName(*participant)))
+		}
+	}
+
+	return notes
+}
+
+// route labels the branch of a router or a multicast with its name, condition and whether it's the default.
+func (diagram *diagram) route(step iflow.Step, flow iflow.SequenceFlow, idx int) string {
+	styles := diagram.styles.ArchivePane.Diagram
+
+	name := flow.Name
+	if name == "" {
+		name = fmt.Sprintf("Branch %d", idx+1)
+	}
+
+	label := styles.Route.Render(name)
+
+	switch {
+	case step.Default == flow.ID:
+		label += styles.Type.Render(" (default)")
+	case flow.Condition != "":
+		label += styles.Type.Render(" if " + strings.Join(strings.Fields(flow.Condition), " "))
+	}
+
+	return label
+}
+
+func (diagram *diagram) shape(step iflow.Step) shape {
+	styles := diagram.styles.ArchivePane.Diagram
+
+	switch {
+	case strings.HasSuffix(step.Element, "Event"):
+		return shape{border: lipgloss.RoundedBorder(), connector: "┬", style: styles.Event}
+	case strings.HasSuffix(step.Element, "Gateway"):
+		return shape{border: lipgloss.ThickBorder(), connector: "┯", style: styles.Gateway}
+	default:
+		return shape{border: lipgloss.NormalBorder(), connector: "┬", style: styles.Activity}
+	}
+}
+
+func (diagram *diagram) add(prefix, line string) {
+	diagram.lines = append(diagram.lines, diagram.styles.ArchivePane.Diagram.Connector.Render(prefix)+line)
+}
+
+func stepName(step iflow.Step) string {
+	if step.Name != "" {
+		return step.Name
+	}
+
+	if step.Type != "" {
+		return words(step.Type)
+	}
+
+	return words(step.Element)
+}
+
+// stepType describes the step, e.g. Message start event or Groovy Script.
+func stepType(step iflow.Step) string {
+	if strings.HasSuffix(step.Element, "Event") {
+		description := strings.ToLower(words(step.Element))
+		if len(step.Events) > 0 {
+			description = step.Events[0] + " " + description
+		}
+
+		return words(description)
+	}
+
+	if step.Type != "" {
+		return words(step.Type)
+	}
+
+	return words(step.Element)
+}
+
+func participantName(participant iflow.Participant) string {
+	if participant.Name != "" {
+		return participant.Name
+	}
+
+	return participant.ID
+}
+
+// adapter names the adapter type of the channel, followed by the name of the channel if it differs.
+func adapter(channel iflow.Channel) string {
+	switch {
+	case channel.AdapterType == "":
+		return channel.Name
+	case channel.Name == "" || channel.Name == channel.AdapterType:
+		return channel.AdapterType
+	default:
+		return channel.AdapterType + " (" + channel.Name + ")"
+	}
+}
+
+// words splits a camel case name into words and capitalises the first one, e.g. exclusiveGateway becomes
+// Exclusive Gateway and XSLTMapping becomes XSLT Mapping.
+func words(name string) string {
+	runes := []rune(name)
+
+	var builder strings.Builder
+
+	for idx, r := range runes {
+		if idx > 0 && unicode.IsUpper(r) {
+			previous := runes[idx-1]
+			nextIsLower := idx+1 < len(runes) && unicode.IsLower(runes[idx+1])
+
+			if unicode.IsLower(previous) || unicode.IsDigit(previous) || (unicode.IsUpper(previous) && nextIsLower) {
+				builder.WriteRune(' ')
+			}
+		}
+
+		if idx == 0 {
+			r = unicode.ToUpper(r)
+		}
+
+		builder.WriteRune(r)
+	}
+
+	return builder.String()
+}
+
+func boolToInt(value bool) int {
+	if value {
+		return 1
+	}
+
+	return 0
+}
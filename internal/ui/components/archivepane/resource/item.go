@@ -0,0 +1,92 @@
+package resource
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/archive"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/format"
+)
+
+type Item archive.Resource
+
+type ItemDelegate struct {
+	common common.Common
+}
+
+const (
+	kindColumnWidth = 18
+	sizeColumnWidth = 10
+	columnGap       = 1
+)
+
+func (item Item) FilterValue() string {
+	return item.Path
+}
+
+func NewResourceItemDelegate() ItemDelegate {
+	return ItemDelegate{
+		common: common.New(),
+	}
+}
+
+func (ItemDelegate) Height() int {
+	return 1
+}
+
+func (ItemDelegate) Spacing() int {
+	return 0
+}
+
+func (ItemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd {
+	return nil
+}
+
+func (itemDelegate ItemDelegate) Render(writer io.Writer, model list.Model, index int, listItem list.Item) {
+	item := listItem.(Item)
+	styles := itemDelegate.common.Styles.ArchivePane.Dataset
+
+	var style lipgloss.Style
+	if index == model.Index() {
+		style = styles.Item.Selected
+	} else {
+		style = styles.Item.Normal
+	}
+
+	cell := lipgloss.NewStyle().
+		Background(style.GetBackground()).
+		Foreground(style.GetForeground())
+
+	kind := cell
+	if index != model.Index() {
+		kind = styles.Item.Kind.Inherit(cell)
+	}
+
+	fmt.Fprint(writer, style.Width(model.Width()).MaxWidth(model.Width()).Render(
+		row(model.Width(), cell, kind, item.Path, item.Kind, format.Size(item.Size)),
+	))
+}
+
+// row lays out the path, kind and size columns of the resources table within the width.
+// The kind column is rendered with its own style.
+func row(width int, style, kindStyle lipgloss.Style, path, kind, size string) string {
+	pathWidth := max(0, width-kindColumnWidth-sizeColumnWidth-2*columnGap)
+	gap := style.Render(strings.Repeat(" ", columnGap))
+
+	// Paths are truncated from the left, so that names of resources remain visible.
+	if ansi.StringWidth(path) > pathWidth {
+		path = ansi.TruncateLeft(path, ansi.StringWidth(path)-pathWidth+1, "…")
+	}
+
+	return style.Width(pathWidth).Render(path) +
+		gap +
+		kindStyle.Width(kindColumnWidth).Render(ansi.Truncate(kind, kindColumnWidth, "…")) +
+		gap +
+		style.Width(sizeColumnWidth).AlignHorizontal(lipgloss.Right).Render(size)
+}
@@ -0,0 +1,547 @@
+package resource
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/archive"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/iflow"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/format"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/highlight"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/pane"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/tools/editor"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/tools/file"
+)
+
+// Model lists resources in the archive of a design-time integration artifact, e.g. the BPMN model,
+// scripts and mappings of an integration flow. Text resources are viewed with syntax highlighting,
+// the BPMN model can be viewed as a process diagram, and any resource can be opened in an editor.
+type Model struct {
+	common       common.Common
+	resources    list.Model
+	body         viewport.Model
+	loader       pane.Loader
+	artifactType string
+	artifactID   string
+	artifactName string
+	archive      *archive.Archive
+	extractedTo  string
+	viewed       *archive.Resource
+	numbered     bool
+	diagram      bool
+	showDiagram  bool
+	width        int
+}
+
+// ErrorSource identifies errors of commands that load the archive.
+const ErrorSource = "archive"
+
+// EditErrorSource identifies errors of commands that open resources in an editor.
+const EditErrorSource = "editor"
+
+// Resources larger than this are not displayed, but can still be opened in an editor.
+const maxViewableResourceSize = 1 << 20
+
+const (
+	tabWidth        = 4
+	horizontalStep  = 8
+	lineNumberGap   = 1
+	minNumberDigits = 3
+)
+
+type (
+	ArchiveMsg struct {
+		ArtifactID string
+		Archive    *archive.Archive
+		ctx        context.Context
+	}
+	// ExtractedMsg reports the directory the archive has been extracted to, so that the resource
+	// can be opened in an editor.
+	ExtractedMsg struct {
+		Archive  *archive.Archive
+		Dir      string
+		Resource archive.Resource
+	}
+	// ViewMsg carries content of the resource to be viewed, as is or as a process diagram.
+	ViewMsg struct {
+		Resource archive.Resource
+		Content  []byte
+		Diagram  bool
+	}
+)
+
+func New() *Model {
+	common := common.New()
+	styles := common.Styles.ArchivePane
+
+	resources := list.New(make([]list.Item, 0), NewResourceItemDelegate(), 0, 0)
+	resources.DisableQuitKeybindings()
+	resources.SetShowHelp(false)
+	resources.SetShowTitle(false)
+	resources.SetShowPagination(false)
+	resources.SetShowStatusBar(false)
+	resources.SetFilteringEnabled(false)
+	resources.SetStatusBarItemName("resource", "resources")
+	resources.Styles.NoItems = styles.Dataset.NoItems
+
+	body := viewport.New(0, 0)
+	body.SetHorizontalStep(horizontalStep)
+
+	return &Model{
+		common:    common,
+		resources: resources,
+		body:      body,
+		loader:    pane.NewLoader(ErrorSource, styles.Dataset.Loading),
+	}
+}
+
+func (*Model) Init() tea.Cmd {
+	return nil
+}
+
+func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var (
+		cmd  tea.Cmd
+		cmds = make([]tea.Cmd, 0)
+	)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, model.common.KeyMap.Edit):
+			cmds = append(cmds, model.editCmd())
+
+		case key.Matches(msg, model.common.KeyMap.Diagram):
+			cmds = append(cmds, model.diagramCmd())
+
+		case model.viewed != nil:
+			model.body, cmd = model.body.Update(msg)
+			cmds = append(cmds, cmd)
+
+		case key.Matches(msg, model.common.KeyMap.Up), key.Matches(msg, model.common.KeyMap.Down):
+			model.resources, cmd = model.resources.Update(msg)
+			cmds = append(cmds, cmd)
+
+		case key.Matches(msg, model.common.KeyMap.Enter):
+			cmds = append(cmds, model.viewCmd())
+
+		case key.Matches(msg, model.common.KeyMap.Refresh):
+			cmds = append(cmds, model.ArchiveCmd())
+		}
+
+	case spinner.TickMsg, err.ErrorMsg, err.RetryMsg:
+		cmds = append(cmds, model.loader.Update(msg))
+
+	case ArchiveMsg:
+		// The archive belongs to a load that has been superseded by a newer one.
+		if !model.loader.Done(msg.ctx) {
+			break
+		}
+
+		model.archive = msg.Archive
+		model.extractedTo = ""
+
+		if model.showDiagram {
+			model.showDiagram = false
+			cmds = append(cmds, model.diagramCmd())
+		}
+
+		items := make([]list.Item, 0, len(msg.Archive.Resources))
+		for _, resource := range msg.Archive.Resources {
+			items = append(items, Item(resource))
+		}
+
+		cmds = append(cmds,
+			statusbar.StatusMessageCmd(fmt.Sprintf("Loaded %d resources in %d ms",
+				len(items), model.loader.Elapsed().Milliseconds())),
+			statusbar.RefreshedCmd("Archive", time.Now()),
+			model.resources.SetItems(items),
+		)
+
+	case ViewMsg:
+		model.viewed = &msg.Resource
+		model.diagram = msg.Diagram
+
+		if msg.Diagram {
+			model.renderDiagram(msg.Content)
+		} else {
+			model.render(msg.Resource, msg.Content)
+		}
+
+	case ExtractedMsg:
+		if msg.Archive == model.archive {
+			model.extractedTo = msg.Dir
+		}
+
+		path, e := msg.Resource.ExtractedPath(msg.Dir)
+		if e != nil {
+			cmds = append(cmds, func() tea.Msg {
+				return err.ErrorMsg{Err: e, Source: EditErrorSource}
+			})
+
+			break
+		}
+
+		cmds = append(cmds, editor.OpenFileCmd(path))
+	}
+
+	return model, tea.Batch(cmds...)
+}
+
+// SetSize resizes the pane, including its border.
+func (model *Model) SetSize(width, height int) {
+	styles := model.common.Styles.ArchivePane
+
+	model.width = max(1, width-styles.Area.GetHorizontalFrameSize())
+	model.common.Styles.ArchivePane.Area = styles.Area.
+		Width(model.width).
+		Height(max(0, height-styles.Area.GetVerticalFrameSize()))
+	model.common.Styles.ArchivePane.Title = styles.Title.Width(model.width)
+
+	// The title, the table header and the footer surround the list.
+	const reservedLines = 4
+
+	model.resources.SetSize(model.width, max(1, height-styles.Area.GetVerticalFrameSize()-reservedLines))
+	model.resources.Styles.NoItems = model.resources.Styles.NoItems.Width(model.width)
+
+	// The viewer takes the place of the table header, too.
+	model.body.Height = model.resources.Height() + 1
+	model.body.Width = max(1, model.width-model.gutterWidth())
+}
+
+func (model *Model) View() string {
+	styles := model.common.Styles.ArchivePane
+
+	if model.viewed != nil {
+		body := model.body.View()
+		if model.numbered {
+			body = lipgloss.JoinHorizontal(lipgloss.Top, model.gutterView(), body)
+		}
+
+		title := model.viewed.Path
+		if model.diagram {
+			title = "Process diagram of " + model.artifactName
+		}
+
+		return styles.Area.Render(lipgloss.JoinVertical(lipgloss.Left,
+			styles.Title.Render(title),
+			body,
+			styles.Footer.Width(model.width).Render(model.footerView()),
+		))
+	}
+
+	content := model.loader.View(model.resources, "Loading archive…")
+
+	return styles.Area.Render(lipgloss.JoinVertical(lipgloss.Left,
+		styles.Title.Render("Archive of "+model.artifactName),
+		styles.Header.Width(model.width).Render(
+			row(model.width, styles.Header, styles.Header, "Resource", "Kind", "Size"),
+		),
+		lipgloss.NewStyle().Height(model.resources.Height()).Render(content),
+		styles.Footer.Width(model.width).Render(model.footerView()),
+	))
+}
+
+func (model *Model) footerView() string {
+	keys := model.common.KeyMap
+	count := len(model.resources.Items())
+
+	switch {
+	case model.viewed != nil:
+		const percent = 100
+
+		toggle := ""
+
+		switch {
+		case model.diagram:
+			toggle = fmt.Sprintf(" · %s source", keys.Diagram.Help().Key)
+		case model.viewed.Kind == archive.KindIntegrationFlow:
+			toggle = fmt.Sprintf(" · %s diagram", keys.Diagram.Help().Key)
+		}
+
+		return fmt.Sprintf("%3.0f%% · %s/%s scroll%s · %s open in editor · %s back",
+			model.body.ScrollPercent()*percent, keys.Left.Help().Key, keys.Right.Help().Key,
+			toggle, keys.Edit.Help().Key, keys.Close.Help().Key)
+	case model.loader.Loading():
+		return model.loader.Spinner() + " Loading archive…"
+	case count > 0 && model.flowModel() != nil:
+		return fmt.Sprintf("%d resources · %s view · %s process diagram · %s open in editor · %s back",
+			count, keys.Enter.Help().Key, keys.Diagram.Help().Key, keys.Edit.Help().Key, keys.Close.Help().Key)
+	case count > 0:
+		return fmt.Sprintf("%d resources · %s view · %s open in editor · %s back",
+			count, keys.Enter.Help().Key, keys.Edit.Help().Key, keys.Close.Help().Key)
+	default:
+		return ""
+	}
+}
+
+// gutterView renders numbers of the lines that are displayed in the viewer.
+func (model *Model) gutterView() string {
+	style := model.common.Styles.ArchivePane.LineNumber
+	width := model.gutterWidth() - lineNumberGap
+	first := model.body.YOffset + 1
+	last := min(model.body.TotalLineCount(), model.body.YOffset+model.body.Height)
+
+	numbers := make([]string, 0, model.body.Height)
+	for number := first; number <= last; number++ {
+		numbers = append(numbers, strconv.Itoa(number))
+	}
+
+	return style.Width(width).Height(model.body.Height).MarginRight(lineNumberGap).
+		Render(strings.Join(numbers, "\n"))
+}
+
+func (model *Model) gutterWidth() int {
+	if model.viewed == nil || !model.numbered {
+		return 0
+	}
+
+	return max(minNumberDigits, len(strconv.Itoa(model.body.TotalLineCount()))) + lineNumberGap
+}
+
+// render displays content of the resource in the viewer. Binary and large resources are not displayed.
+func (model *Model) render(resource archive.Resource, content []byte) {
+	styles := model.common.Styles.ArchivePane
+	editKey := model.common.KeyMap.Edit.Help().Key
+
+	var lines []string
+
+	model.numbered = false
+
+	switch {
+	case len(content) == 0:
+		lines = []string{styles.Empty.Render("The resource is empty")}
+	case len(content) > maxViewableResourceSize:
+		lines = []string{styles.Empty.Render(fmt.Sprintf("The resource is too large to display (%s), open it in an editor with %s",
+			format.Size(int64(len(content))), editKey))}
+	case !utf8.Valid(content) || bytes.IndexByte(content, 0) != -1:
+		lines = []string{styles.Empty.Render(fmt.Sprintf("The resource is binary (%s), open it in an editor with %s",
+			format.Size(int64(len(content))), editKey))}
+	default:
+		lines = highlight.Lines(resource.Path, printable(string(content)))
+		model.numbered = true
+	}
+
+	model.body.SetContent(strings.Join(lines, "\n"))
+	model.body.GotoTop()
+	model.body.SetXOffset(0)
+	model.body.Width = max(1, model.width-model.gutterWidth())
+}
+
+// OpenDiagram lists resources in the archive of the integration flow and shows its process diagram
+// once the archive has been loaded.
+func (model *Model) OpenDiagram(artifactID, artifactName string) tea.Cmd {
+	cmd := model.Open(api.SupportedArtifactTypes().Designtime.IntegrationFlow.Name, artifactID, artifactName)
+	model.showDiagram = true
+
+	return cmd
+}
+
+// Open lists resources in the archive of the design-time artifact.
+func (model *Model) Open(artifactType, artifactID, artifactName string) tea.Cmd {
+	if artifactType != model.artifactType || artifactID != model.artifactID {
+		model.resources.SetItems(make([]list.Item, 0))
+		model.archive = nil
+		model.extractedTo = ""
+	}
+
+	model.artifactType = artifactType
+	model.artifactID = artifactID
+	model.artifactName = artifactName
+	model.viewed = nil
+	model.showDiagram = false
+
+	return model.ArchiveCmd()
+}
+
+// Back closes the resource that is viewed. It reports false if no resource is viewed.
+func (model *Model) Back() bool {
+	if model.viewed == nil {
+		return false
+	}
+
+	model.viewed = nil
+
+	return true
+}
+
+// ArchiveCmd downloads the archive of the active version of the artifact, cancelling the load that is
+// still in progress, if any.
+func (model *Model) ArchiveCmd() tea.Cmd {
+	artifactType, artifactID := model.artifactType, model.artifactID
+
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Fetching archive…"),
+		model.loader.Load(func(ctx context.Context) (tea.Msg, error) {
+			var content bytes.Buffer
+
+			if e := api.DownloadArtifact(ctx, artifactType, artifactID, &content, nil); e != nil {
+				return nil, e
+			}
+
+			opened, e := archive.Open(content.Bytes())
+			if e != nil {
+				return nil, e
+			}
+
+			return ArchiveMsg{
+				ArtifactID: artifactID,
+				Archive:    opened,
+				ctx:        ctx,
+			}, nil
+		}),
+	)
+}
+
+// CancelCmds cancels the load that is still in progress, if any.
+func (model *Model) CancelCmds() {
+	model.loader.Cancel()
+	model.showDiagram = false
+}
+
+// SelectedResource returns the viewed resource or the resource that is selected in the list, if any.
+func (model *Model) SelectedResource() *archive.Resource {
+	if model.viewed != nil {
+		return model.viewed
+	}
+
+	selectedItem := model.resources.SelectedItem()
+	if selectedItem == nil {
+		return nil
+	}
+
+	resource := archive.Resource(selectedItem.(Item))
+
+	return &resource
+}
+
+func (model *Model) viewCmd() tea.Cmd {
+	resource := model.SelectedResource()
+	if resource == nil {
+		return nil
+	}
+
+	return func() tea.Msg {
+		content, e := resource.Read()
+		if e != nil {
+			return err.ErrorMsg{Err: e, Source: EditErrorSource}
+		}
+
+		return ViewMsg{Resource: *resource, Content: content}
+	}
+}
+
+// diagramCmd shows the process diagram of the integration flow, or the source of its BPMN model
+// when the diagram is already shown.
+func (model *Model) diagramCmd() tea.Cmd {
+	resource := model.flowModel()
+	if resource == nil {
+		return nil
+	}
+
+	diagram := !model.diagram || model.viewed == nil
+
+	return func() tea.Msg {
+		content, e := resource.Read()
+		if e != nil {
+			return err.ErrorMsg{Err: e, Source: EditErrorSource}
+		}
+
+		return ViewMsg{Resource: *resource, Content: content, Diagram: diagram}
+	}
+}
+
+// flowModel returns the BPMN model of the integration flow: the selected or viewed one, if it is a model,
+// or else the first one in the archive.
+func (model *Model) flowModel() *archive.Resource {
+	if selected := model.SelectedResource(); selected != nil && selected.Kind == archive.KindIntegrationFlow {
+		return selected
+	}
+
+	if model.archive == nil {
+		return nil
+	}
+
+	for _, resource := range model.archive.Resources {
+		if resource.Kind == archive.KindIntegrationFlow {
+			return &resource
+		}
+	}
+
+	return nil
+}
+
+// editCmd opens the selected resource in an editor. The archive is extracted to a temporary directory
+// when a resource is opened for the first time, so that the resource is opened next to the resources
+// it refers to.
+func (model *Model) editCmd() tea.Cmd {
+	resource := model.SelectedResource()
+	if resource == nil || model.archive == nil {
+		return nil
+	}
+
+	opened, dir := model.archive, model.extractedTo
+
+	return func() tea.Msg {
+		if dir == "" {
+			var e error
+
+			if dir, e = file.CreateTempDir("cpi-navigator-*"); e != nil {
+				return err.ErrorMsg{Err: fmt.Errorf("error extracting archive: %w", e), Source: EditErrorSource}
+			}
+
+			if e = opened.Extract(dir); e != nil {
+				return err.ErrorMsg{Err: e, Source: EditErrorSource}
+			}
+		}
+
+		return ExtractedMsg{Archive: opened, Dir: dir, Resource: *resource}
+	}
+}
+
+// renderDiagram displays the process diagram of the BPMN model in the viewer.
+func (model *Model) renderDiagram(content []byte) {
+	var lines []string
+
+	flow, e := iflow.Parse(content)
+	if e != nil {
+		lines = []string{model.common.Styles.ArchivePane.Empty.Render(
+			fmt.Sprintf("The process diagram cannot be drawn: %s", e))}
+	} else {
+		lines = diagramLines(flow, model.common.Styles)
+	}
+
+	model.numbered = false
+	model.body.SetContent(strings.Join(lines, "\n"))
+	model.body.GotoTop()
+	model.body.SetXOffset(0)
+	model.body.Width = max(1, model.width-model.gutterWidth())
+}
+
+// printable replaces tabs and control characters that would break the layout.
+func printable(text string) string {
+	text = strings.ReplaceAll(strings.ReplaceAll(text, "\r\n", "\n"), "\t", strings.Repeat(" ", tabWidth))
+
+	return strings.Map(func(r rune) rune {
+		if r < ' ' && r != '\n' {
+			return -1
+		}
+
+		return r
+	}, text)
+}
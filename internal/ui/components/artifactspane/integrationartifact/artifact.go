@@ -1,20 +1,26 @@
 package integrationartifact
 
 import (
+	"context"
+	"fmt"
 	"net/url"
 	"slices"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/vadimklimov/cpi-navigator/internal/config"
 	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/filter"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/pane"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/common/sort"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/components/artifactspane/tab"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/components/attributespane/attribute"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
 )
 
 type Model struct {
@@ -24,6 +30,19 @@ type Model struct {
 	messagemappings      list.Model
 	scriptcollections    list.Model
 	selectedArtifactType string
+	loaders              map[string]*pane.Loader
+	runtimeLoader        pane.Loader
+	pendingSelection     *pendingSelection
+	runtimeArtifacts     map[string]api.IntegrationRuntimeArtifact
+	// Deployments and polling of their status run in the context of the pane and are cancelled
+	// once another content package is selected.
+	deployments      context.Context
+	deploymentCancel context.CancelFunc
+}
+
+// pendingSelection is an artifact to be selected as soon as artifacts of its type are loaded.
+type pendingSelection struct {
+	artifactType, artifactID string
 }
 
 type (
@@ -31,8 +50,29 @@ type (
 	ValueMappingsMsg     []api.IntegrationArtifact
 	MessageMappingsMsg   []api.IntegrationArtifact
 	ScriptCollectionsMsg []api.IntegrationArtifact
+	ArtifactsPageMsg     struct {
+		PackageID    string
+		ArtifactType string
+		Artifacts    []api.IntegrationArtifact
+		Next         string
+		ctx          context.Context
+	}
+	FilterMatchesMsg struct {
+		ArtifactType string
+		Matches      list.FilterMatchesMsg
+	}
+	RuntimeArtifactsMsg struct {
+		Artifacts []api.IntegrationRuntimeArtifact
+		ctx       context.Context
+	}
 )
 
+// runtimeAttributesPosition is the position of runtime attributes among attributes of the artifact.
+const runtimeAttributesPosition = 3
+
+// RuntimeErrorSource identifies errors of loading artifacts deployed to the runtime.
+const RuntimeErrorSource = "runtime"
+
 var supportedArtifactTypes = api.SupportedArtifactTypes()
 
 func New() *Model {
@@ -46,16 +86,23 @@ func New() *Model {
 		list.DisableQuitKeybindings()
 		list.SetShowHelp(false)
 		list.SetShowTitle(false)
-		list.SetFilteringEnabled(false)
 		list.SetShowPagination(false)
 		list.SetShowStatusBar(false)
 		list.SetStatusBarItemName("artifact", "artifacts")
 		list.InfiniteScrolling = true
 		list.Styles.NoItems = common.Styles.IntegrationArtifactsPane.Dataset.NoItems
+		filter.Setup(&list, common.Styles.IntegrationArtifactsPane.Dataset.Filter)
 
 		return list
 	}
 
+	// Artifacts of each type are loaded separately, and errors of their loads are sourced by the artifact type.
+	loaders := make(map[string]*pane.Loader)
+	for _, artifactType := range artifactTypes() {
+		loader := pane.NewLoader(artifactType, common.Styles.IntegrationArtifactsPane.Dataset.Loading)
+		loaders[artifactType] = &loader
+	}
+
 	return &Model{
 		common:               common,
 		integrationflows:     init(),
@@ -63,11 +110,25 @@ func New() *Model {
 		messagemappings:      init(),
 		scriptcollections:    init(),
 		selectedArtifactType: supportedArtifactTypes.Designtime.IntegrationFlow.Name,
+		loaders:              loaders,
+		runtimeLoader:        pane.NewLoader(RuntimeErrorSource, common.Styles.IntegrationArtifactsPane.Dataset.Loading),
 	}
 }
 
 func (model *Model) Init() tea.Cmd {
+	for _, loader := range model.loaders {
+		loader.Reset()
+	}
+
+	model.runtimeLoader.Reset()
 	model.selectedArtifactType = supportedArtifactTypes.Designtime.IntegrationFlow.Name
+	model.pendingSelection = nil
+
+	for _, artifactType := range artifactTypes() {
+		list := model.artifactsList(artifactType)
+		list.ResetFilter()
+		filter.SyncView(list)
+	}
 
 	return tea.Batch(
 		model.IntegrationFlowsInitCmd,
@@ -85,58 +146,128 @@ func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
-		switch {
-		case key.Matches(msg, model.common.KeyMap.Up), key.Matches(msg, model.common.KeyMap.Down):
-			switch model.selectedArtifactType {
-			case supportedArtifactTypes.Designtime.IntegrationFlow.Name:
-				model.integrationflows, cmd = model.integrationflows.Update(msg)
-			case supportedArtifactTypes.Designtime.ValueMapping.Name:
-				model.valuemappings, cmd = model.valuemappings.Update(msg)
-			case supportedArtifactTypes.Designtime.MessageMapping.Name:
-				model.messagemappings, cmd = model.messagemappings.Update(msg)
-			case supportedArtifactTypes.Designtime.ScriptCollection.Name:
-				model.scriptcollections, cmd = model.scriptcollections.Update(msg)
-			}
+		list := model.artifactsList(model.selectedArtifactType)
 
-			cmds = append(cmds, cmd)
+		switch {
+		case list.SettingFilter(),
+			key.Matches(msg, model.common.KeyMap.Up),
+			key.Matches(msg, model.common.KeyMap.Down),
+			key.Matches(msg, model.common.KeyMap.Filter),
+			key.Matches(msg, model.common.KeyMap.ClearFilter):
+			*list, cmd = list.Update(msg)
+			cmds = append(cmds, model.filterCmd(model.selectedArtifactType, cmd))
+
+			filter.SyncView(list)
 		}
 
+	case FilterMatchesMsg:
+		list := model.artifactsList(msg.ArtifactType)
+		*list, cmd = list.Update(msg.Matches)
+		cmds = append(cmds, cmd)
+
 	case tab.ActiveTabMsg:
 		model.selectedArtifactType = string(msg)
 
+	case spinner.TickMsg, err.ErrorMsg, err.RetryMsg:
+		for _, loader := range model.loaders {
+			cmds = append(cmds, loader.Update(msg))
+		}
+
+		cmds = append(cmds, model.runtimeLoader.Update(msg))
+
+	case ArtifactsPageMsg:
+		loader := model.loaders[msg.ArtifactType]
+
+		if msg.Next != "" {
+			// The page belongs to a load that has been superseded by a newer one.
+			if !loader.Current(msg.ctx) {
+				break
+			}
+
+			cmds = append(cmds,
+				statusbar.StatusMessageCmd(fmt.Sprintf("Fetching %s… %d loaded",
+					artifactTypeLabel(msg.ArtifactType), len(msg.Artifacts))),
+				loader.Continue(artifactsByPackageAndTypePage(msg.PackageID, msg.ArtifactType, msg.Artifacts, msg.Next)),
+			)
+
+			break
+		}
+
+		if !loader.Done(msg.ctx) {
+			break
+		}
+
+		cmds = append(cmds,
+			statusbar.StatusMessageCmd(fmt.Sprintf("Loaded %d %s in %d ms",
+				len(msg.Artifacts), artifactTypeLabel(msg.ArtifactType), loader.Elapsed().Milliseconds())),
+			statusbar.RefreshedCmd("Artifacts", time.Now()),
+			artifactsCmd(msg.ctx, msg.ArtifactType, msg.Artifacts),
+		)
+
+	case RuntimeArtifactsMsg:
+		if !model.runtimeLoader.Done(msg.ctx) {
+			break
+		}
+
+		model.runtimeArtifacts = make(map[string]api.IntegrationRuntimeArtifact, len(msg.Artifacts))
+		for _, artifact := range msg.Artifacts {
+			model.runtimeArtifacts[artifact.ID] = artifact
+		}
+
+		cmds = append(cmds, model.joinRuntimeArtifacts()...)
+
+	case DeploymentMsg:
+		cmds = append(cmds, model.updateDeployment(msg))
+
 	case IntegrationFlowsMsg:
-		model.integrationflows.SetItems(convertArtifactsToListItems(msg))
+		cmds = append(cmds, model.filterCmd(supportedArtifactTypes.Designtime.IntegrationFlow.Name,
+			model.integrationflows.SetItems(model.convertArtifactsToListItems(msg))))
 		model.integrationflows.ResetSelected()
+		model.selectPending(supportedArtifactTypes.Designtime.IntegrationFlow.Name)
 
 	case ValueMappingsMsg:
-		model.valuemappings.SetItems(convertArtifactsToListItems(msg))
+		cmds = append(cmds, model.filterCmd(supportedArtifactTypes.Designtime.ValueMapping.Name,
+			model.valuemappings.SetItems(model.convertArtifactsToListItems(msg))))
 		model.valuemappings.ResetSelected()
+		model.selectPending(supportedArtifactTypes.Designtime.ValueMapping.Name)
 
 	case MessageMappingsMsg:
-		model.messagemappings.SetItems(convertArtifactsToListItems(msg))
+		cmds = append(cmds, model.filterCmd(supportedArtifactTypes.Designtime.MessageMapping.Name,
+			model.messagemappings.SetItems(model.convertArtifactsToListItems(msg))))
 		model.messagemappings.ResetSelected()
+		model.selectPending(supportedArtifactTypes.Designtime.MessageMapping.Name)
 
 	case ScriptCollectionsMsg:
-		model.scriptcollections.SetItems(convertArtifactsToListItems(msg))
+		cmds = append(cmds, model.filterCmd(supportedArtifactTypes.Designtime.ScriptCollection.Name,
+			model.scriptcollections.SetItems(model.convertArtifactsToListItems(msg))))
 		model.scriptcollections.ResetSelected()
+		model.selectPending(supportedArtifactTypes.Designtime.ScriptCollection.Name)
 	}
 
 	return model, tea.Batch(cmds...)
 }
 
+// SetSize resizes the lists of integration artifacts of all types.
+func (model *Model) SetSize(width, height int) {
+	for _, list := range []*list.Model{
+		&model.integrationflows,
+		&model.valuemappings,
+		&model.messagemappings,
+		&model.scriptcollections,
+	} {
+		list.SetSize(width, height)
+		list.Styles.NoItems = list.Styles.NoItems.Width(width)
+	}
+}
+
 func (model *Model) View() string {
-	switch model.selectedArtifactType {
-	case supportedArtifactTypes.Designtime.IntegrationFlow.Name:
-		return model.integrationflows.View()
-	case supportedArtifactTypes.Designtime.ValueMapping.Name:
-		return model.valuemappings.View()
-	case supportedArtifactTypes.Designtime.MessageMapping.Name:
-		return model.messagemappings.View()
-	case supportedArtifactTypes.Designtime.ScriptCollection.Name:
-		return model.scriptcollections.View()
-	default:
+	loader, ok := model.loaders[model.selectedArtifactType]
+	if !ok {
 		return model.integrationflows.View()
 	}
+
+	return loader.View(*model.artifactsList(model.selectedArtifactType),
+		fmt.Sprintf("Loading %s…", artifactTypeLabel(model.selectedArtifactType)))
 }
 
 func (model *Model) IntegrationFlowsInitCmd() tea.Msg {
@@ -163,63 +294,207 @@ func (model *Model) ScriptCollectionsInitCmd() tea.Msg {
 	return ScriptCollectionsMsg(make([]api.IntegrationArtifact, 0))
 }
 
+// IntegrationArtifactsByPackageCmd loads artifacts of all types in the content package,
+// cancelling the loads that are still in progress, if any.
 func (model *Model) IntegrationArtifactsByPackageCmd(packageID string) tea.Cmd {
 	return tea.Batch(
+		statusbar.StatusMessageCmd("Fetching integration artifacts…"),
 		model.IntegrationFlowsByPackageCmd(packageID),
 		model.ValueMappingsByPackageCmd(packageID),
 		model.MessageMappingsByPackageCmd(packageID),
 		model.ScriptCollectionsByPackageCmd(packageID),
+		model.runtimeLoader.Load(runtimeArtifacts),
 	)
 }
 
-func (*Model) IntegrationFlowsByPackageCmd(packageID string) tea.Cmd {
-	return func() tea.Msg {
-		integrationflows, e := api.IntegrationArtifactsByPackageAndType(packageID,
-			supportedArtifactTypes.Designtime.IntegrationFlow.Name)
-		if e != nil {
-			return err.ErrorMsg(e)
-		}
+func (model *Model) IntegrationFlowsByPackageCmd(packageID string) tea.Cmd {
+	return model.artifactsByPackageAndTypeCmd(packageID, supportedArtifactTypes.Designtime.IntegrationFlow.Name)
+}
+
+func (model *Model) ValueMappingsByPackageCmd(packageID string) tea.Cmd {
+	return model.artifactsByPackageAndTypeCmd(packageID, supportedArtifactTypes.Designtime.ValueMapping.Name)
+}
+
+func (model *Model) MessageMappingsByPackageCmd(packageID string) tea.Cmd {
+	return model.artifactsByPackageAndTypeCmd(packageID, supportedArtifactTypes.Designtime.MessageMapping.Name)
+}
 
-		return IntegrationFlowsMsg(integrationflows)
+func (model *Model) ScriptCollectionsByPackageCmd(packageID string) tea.Cmd {
+	return model.artifactsByPackageAndTypeCmd(packageID, supportedArtifactTypes.Designtime.ScriptCollection.Name)
+}
+
+// SelectArtifact selects the artifact once artifacts of its type are loaded.
+func (model *Model) SelectArtifact(artifactType, artifactID string) {
+	model.pendingSelection = &pendingSelection{artifactType: artifactType, artifactID: artifactID}
+}
+
+func (model *Model) selectPending(artifactType string) {
+	if model.pendingSelection == nil || model.pendingSelection.artifactType != artifactType {
+		return
+	}
+
+	artifacts := model.artifactsList(artifactType)
+
+	idx := slices.IndexFunc(artifacts.Items(), func(item list.Item) bool {
+		return item.(Item).ID == model.pendingSelection.artifactID
+	})
+
+	if idx != -1 {
+		artifacts.Select(idx)
+		model.pendingSelection = nil
 	}
 }
 
-func (*Model) ValueMappingsByPackageCmd(packageID string) tea.Cmd {
-	return func() tea.Msg {
-		valuemappings, e := api.IntegrationArtifactsByPackageAndType(packageID,
-			supportedArtifactTypes.Designtime.ValueMapping.Name)
+// CancelCmds cancels artifact loads and deployments that are still in progress.
+func (model *Model) CancelCmds() {
+	for _, loader := range model.loaders {
+		loader.Cancel()
+	}
+
+	model.runtimeLoader.Cancel()
+
+	if model.deploymentCancel != nil {
+		model.deploymentCancel()
+		model.deployments, model.deploymentCancel = nil, nil
+	}
+}
+
+func (model *Model) artifactsByPackageAndTypeCmd(packageID, artifactType string) tea.Cmd {
+	return model.loaders[artifactType].Load(
+		artifactsByPackageAndTypePage(packageID, artifactType, make([]api.IntegrationArtifact, 0), ""),
+	)
+}
+
+// artifactsByPackageAndTypePage fetches the page of artifacts of the type in the content package
+// that follows the ones already loaded.
+func artifactsByPackageAndTypePage(packageID, artifactType string,
+	loaded []api.IntegrationArtifact, next string,
+) func(ctx context.Context) (tea.Msg, error) {
+	return func(ctx context.Context) (tea.Msg, error) {
+		page, e := api.IntegrationArtifactsByPackageAndTypePage(ctx, packageID, artifactType, next)
 		if e != nil {
-			return err.ErrorMsg(e)
+			return nil, e
 		}
 
-		return ValueMappingsMsg(valuemappings)
+		return ArtifactsPageMsg{
+			PackageID:    packageID,
+			ArtifactType: artifactType,
+			Artifacts:    append(loaded, page.Results...),
+			Next:         page.Next,
+			ctx:          ctx,
+		}, nil
 	}
 }
 
-func (*Model) MessageMappingsByPackageCmd(packageID string) tea.Cmd {
-	return func() tea.Msg {
-		messagemappings, e := api.IntegrationArtifactsByPackageAndType(packageID,
-			supportedArtifactTypes.Designtime.MessageMapping.Name)
-		if e != nil {
-			return err.ErrorMsg(e)
+func runtimeArtifacts(ctx context.Context) (tea.Msg, error) {
+	artifacts, e := api.IntegrationRuntimeArtifacts(ctx)
+	if e != nil {
+		return nil, e
+	}
+
+	return RuntimeArtifactsMsg{Artifacts: artifacts, ctx: ctx}, nil
+}
+
+// joinRuntimeArtifacts updates the runtime status of artifacts of all types that are already listed.
+func (model *Model) joinRuntimeArtifacts() []tea.Cmd {
+	cmds := make([]tea.Cmd, 0, len(artifactTypes()))
+
+	for _, artifactType := range artifactTypes() {
+		artifacts := model.artifactsList(artifactType)
+
+		items := artifacts.Items()
+		for idx, item := range items {
+			items[idx] = model.joinRuntimeArtifact(item.(Item))
 		}
 
-		return MessageMappingsMsg(messagemappings)
+		cmds = append(cmds, model.filterCmd(artifactType, artifacts.SetItems(items)))
 	}
+
+	return cmds
 }
 
-func (*Model) ScriptCollectionsByPackageCmd(packageID string) tea.Cmd {
+func (model *Model) joinRuntimeArtifact(item Item) Item {
+	item.Runtime = nil
+	item.RuntimeKnown = model.runtimeArtifacts != nil
+
+	if runtimeArtifact, ok := model.runtimeArtifacts[item.ID]; ok {
+		item.Runtime = &runtimeArtifact
+	}
+
+	return item
+}
+
+func artifactsCmd(ctx context.Context, artifactType string, artifacts []api.IntegrationArtifact) tea.Cmd {
 	return func() tea.Msg {
-		scriptcollections, e := api.IntegrationArtifactsByPackageAndType(packageID,
-			supportedArtifactTypes.Designtime.ScriptCollection.Name)
-		if e != nil {
-			return err.ErrorMsg(e)
+		if ctx.Err() != nil {
+			return nil
 		}
 
-		return ScriptCollectionsMsg(scriptcollections)
+		switch artifactType {
+		case supportedArtifactTypes.Designtime.IntegrationFlow.Name:
+			return IntegrationFlowsMsg(artifacts)
+		case supportedArtifactTypes.Designtime.ValueMapping.Name:
+			return ValueMappingsMsg(artifacts)
+		case supportedArtifactTypes.Designtime.MessageMapping.Name:
+			return MessageMappingsMsg(artifacts)
+		case supportedArtifactTypes.Designtime.ScriptCollection.Name:
+			return ScriptCollectionsMsg(artifacts)
+		default:
+			return nil
+		}
+	}
+}
+
+func artifactTypes() []string {
+	return []string{
+		supportedArtifactTypes.Designtime.IntegrationFlow.Name,
+		supportedArtifactTypes.Designtime.ValueMapping.Name,
+		supportedArtifactTypes.Designtime.MessageMapping.Name,
+		supportedArtifactTypes.Designtime.ScriptCollection.Name,
+	}
+}
+
+func artifactTypeLabel(artifactType string) string {
+	switch artifactType {
+	case supportedArtifactTypes.Designtime.IntegrationFlow.Name:
+		return "integration flows"
+	case supportedArtifactTypes.Designtime.ValueMapping.Name:
+		return "value mappings"
+	case supportedArtifactTypes.Designtime.MessageMapping.Name:
+		return "message mappings"
+	case supportedArtifactTypes.Designtime.ScriptCollection.Name:
+		return "script collections"
+	default:
+		return "artifacts"
+	}
+}
+
+// Filtering reports whether the filter is being edited, in which case all keys are consumed by the filter input.
+func (model *Model) Filtering() bool {
+	return model.artifactsList(model.selectedArtifactType).SettingFilter()
+}
+
+func (model *Model) artifactsList(artifactType string) *list.Model {
+	switch artifactType {
+	case supportedArtifactTypes.Designtime.IntegrationFlow.Name:
+		return &model.integrationflows
+	case supportedArtifactTypes.Designtime.ValueMapping.Name:
+		return &model.valuemappings
+	case supportedArtifactTypes.Designtime.MessageMapping.Name:
+		return &model.messagemappings
+	case supportedArtifactTypes.Designtime.ScriptCollection.Name:
+		return &model.scriptcollections
+	default:
+		return &model.integrationflows
 	}
 }
 
+func (*Model) filterCmd(artifactType string, cmd tea.Cmd) tea.Cmd {
+	return filter.Cmd(cmd, func(msg list.FilterMatchesMsg) tea.Msg {
+		return FilterMatchesMsg{ArtifactType: artifactType, Matches: msg}
+	})
+}
+
 func (model *Model) selectedArtifactItem() list.Item {
 	switch model.selectedArtifactType {
 	case supportedArtifactTypes.Designtime.IntegrationFlow.Name:
@@ -246,6 +521,22 @@ func (model *Model) SelectedArtifactID() *string {
 	return &selectedArtifact.ID
 }
 
+func (model *Model) SelectedArtifactName() *string {
+	selectedArtifactItem := model.selectedArtifactItem()
+	if selectedArtifactItem == nil {
+		return nil
+	}
+
+	selectedArtifact := selectedArtifactItem.(Item)
+
+	return &selectedArtifact.Name
+}
+
+// SelectedArtifactType returns the type of artifacts in the active tab.
+func (model *Model) SelectedArtifactType() string {
+	return model.selectedArtifactType
+}
+
 func (model *Model) SelectedArtifactPackageID() *string {
 	selectedArtifactItem := model.selectedArtifactItem()
 	if selectedArtifactItem == nil {
@@ -265,9 +556,11 @@ func (model *Model) SelectedArtifactAttributes() []attribute.Attribute {
 
 	artifact := selectedArtifactItem.(Item)
 
+	var attributes []attribute.Attribute
+
 	switch model.selectedArtifactType {
 	case supportedArtifactTypes.Designtime.IntegrationFlow.Name:
-		return []attribute.Attribute{
+		attributes = []attribute.Attribute{
 			{Key: "ID", Value: artifact.ID},
 			{Key: "Version", Value: artifact.Version},
 			{Key: "Name", Value: artifact.Name},
@@ -281,7 +574,7 @@ func (model *Model) SelectedArtifactAttributes() []attribute.Attribute {
 	case supportedArtifactTypes.Designtime.ValueMapping.Name,
 		supportedArtifactTypes.Designtime.MessageMapping.Name,
 		supportedArtifactTypes.Designtime.ScriptCollection.Name:
-		return []attribute.Attribute{
+		attributes = []attribute.Attribute{
 			{Key: "ID", Value: artifact.ID},
 			{Key: "Version", Value: artifact.Version},
 			{Key: "Name", Value: artifact.Name},
@@ -291,6 +584,34 @@ func (model *Model) SelectedArtifactAttributes() []attribute.Attribute {
 	default:
 		return []attribute.Attribute{}
 	}
+
+	// Runtime attributes follow the name, so that they stay visible when the attributes pane is short.
+	return slices.Insert(attributes, runtimeAttributesPosition, runtimeAttributes(artifact)...)
+}
+
+// runtimeAttributes describes the deployment of the artifact to the runtime, if runtime artifacts are loaded.
+func runtimeAttributes(artifact Item) []attribute.Attribute {
+	if !artifact.RuntimeKnown {
+		return nil
+	}
+
+	if artifact.Runtime == nil {
+		return []attribute.Attribute{
+			{Key: "Status", Value: "Not deployed"},
+		}
+	}
+
+	version := artifact.Runtime.Version
+	if artifact.Outdated() {
+		version = fmt.Sprintf("%s (differs from design-time version %s)", version, artifact.Version)
+	}
+
+	return []attribute.Attribute{
+		{Key: "Status", Value: artifact.Runtime.Status},
+		{Key: "Deployed ver.", Value: version},
+		{Key: "Deployed by", Value: artifact.Runtime.DeployedBy},
+		{Key: "Deployed at", Value: artifact.Runtime.DeployedOn.String()},
+	}
 }
 
 func (model *Model) SelectedArtifactWebUIURL() *url.URL {
@@ -327,7 +648,7 @@ func (model *Model) SelectedArtifactWebUIURL() *url.URL {
 	)
 }
 
-func convertArtifactsToListItems(artifacts []api.IntegrationArtifact) []list.Item {
+func (model *Model) convertArtifactsToListItems(artifacts []api.IntegrationArtifact) []list.Item {
 	sort.Sort(artifacts, sort.Options{
 		Field: config.UIArtifactsPaneSortField(),
 		Order: config.UIArtifactsPaneSortOrder(),
@@ -335,7 +656,7 @@ func convertArtifactsToListItems(artifacts []api.IntegrationArtifact) []list.Ite
 
 	items := make([]list.Item, 0, len(artifacts))
 	for artifact := range slices.Values(artifacts) {
-		items = append(items, Item(artifact))
+		items = append(items, model.joinRuntimeArtifact(Item{IntegrationArtifact: artifact}))
 	}
 
 	return items
@@ -0,0 +1,296 @@
+package integrationartifact
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
+)
+
+// DeploymentMsg reports the progress of a deployment or undeployment of the artifact. Until the deployment
+// is done, its status is polled.
+type DeploymentMsg struct {
+	Artifact api.IntegrationArtifact
+	Type     string
+	Undeploy bool
+	TaskID   string
+	Done     bool
+	Status   string
+	Error    string
+	polls    int
+	timedOut bool
+	// previous is when the artifact that was in the runtime before the deployment had been deployed,
+	// zero if there was none.
+	previous time.Time
+	ctx      context.Context
+}
+
+// DeployErrorSource identifies errors of deploying and undeploying artifacts. Deployment and undeployment
+// aren't run again with the retry key, as they have to be confirmed, while polling of their status is.
+const DeployErrorSource = "deployment"
+
+const (
+	deploymentPollInterval = 2 * time.Second
+	maxDeploymentPolls     = 90
+)
+
+// DeployCmd deploys the selected artifact to the runtime.
+func (model *Model) DeployCmd() tea.Cmd {
+	item, ok := model.selectedArtifactItem().(Item)
+	if !ok {
+		return nil
+	}
+
+	return deployCmd(model.deploymentContext(), item.IntegrationArtifact, model.selectedArtifactType)
+}
+
+// UndeployCmd removes the selected artifact from the runtime.
+func (model *Model) UndeployCmd() tea.Cmd {
+	item, ok := model.selectedArtifactItem().(Item)
+	if !ok {
+		return nil
+	}
+
+	return undeployCmd(model.deploymentContext(), item.IntegrationArtifact, model.selectedArtifactType)
+}
+
+// SelectedArtifactMayBeDeployed reports whether the selected artifact is deployed to the runtime
+// or its runtime status is unknown.
+func (model *Model) SelectedArtifactMayBeDeployed() bool {
+	item, ok := model.selectedArtifactItem().(Item)
+
+	return ok && (item.Runtime != nil || !item.RuntimeKnown)
+}
+
+// SelectedArtifactRuntimeVersion returns the version of the selected artifact that is deployed to the runtime,
+// if the artifact is known to be deployed.
+func (model *Model) SelectedArtifactRuntimeVersion() *string {
+	item, ok := model.selectedArtifactItem().(Item)
+	if !ok || item.Runtime == nil {
+		return nil
+	}
+
+	return &item.Runtime.Version
+}
+
+// deploymentContext returns the context deployments run in, until another content package is selected.
+func (model *Model) deploymentContext() context.Context {
+	if model.deployments == nil {
+		model.deployments, model.deploymentCancel = context.WithCancel(context.Background())
+	}
+
+	return model.deployments
+}
+
+// deployCmd deploys the artifact. The artifact that is in the runtime before the deployment, if any, is noted,
+// so that it isn't mistaken for the deployed one while the deployment is in progress.
+func deployCmd(ctx context.Context, artifact api.IntegrationArtifact, artifactType string) tea.Cmd {
+	return tea.Batch(
+		statusbar.StatusMessageCmd(fmt.Sprintf("Deploying %s…", artifact.Name)),
+		func() tea.Msg {
+			previous, e := api.IntegrationRuntimeArtifactByID(ctx, artifact.ID)
+
+			var apiErr *api.Error
+			if e != nil && !(errors.As(e, &apiErr) && apiErr.IsNotFound()) {
+				return deploymentErrorMsg(ctx, e, DeploymentMsg{Artifact: artifact, Type: artifactType})
+			}
+
+			taskID, e := api.DeployArtifact(ctx, artifactType, artifact.ID)
+			if e != nil {
+				return deploymentErrorMsg(ctx, e, DeploymentMsg{Artifact: artifact, Type: artifactType})
+			}
+
+			msg := DeploymentMsg{Artifact: artifact, Type: artifactType, TaskID: taskID, ctx: ctx}
+			if previous != nil {
+				msg.previous = previous.DeployedOn.Time
+			}
+
+			return msg
+		},
+	)
+}
+
+func undeployCmd(ctx context.Context, artifact api.IntegrationArtifact, artifactType string) tea.Cmd {
+	return tea.Batch(
+		statusbar.StatusMessageCmd(fmt.Sprintf("Undeploying %s…", artifact.Name)),
+		func() tea.Msg {
+			if e := api.UndeployArtifact(ctx, artifact.ID); e != nil {
+				return deploymentErrorMsg(ctx, e, DeploymentMsg{Artifact: artifact, Type: artifactType, Undeploy: true})
+			}
+
+			return DeploymentMsg{Artifact: artifact, Type: artifactType, Undeploy: true, ctx: ctx}
+		},
+	)
+}
+
+// updateDeployment polls the status of the deployment that is in progress or reports its outcome.
+// Polling stops once another content package is selected.
+func (model *Model) updateDeployment(msg DeploymentMsg) tea.Cmd {
+	if !msg.Done {
+		if msg.ctx.Err() != nil {
+			return statusbar.StatusMessageCmd(fmt.Sprintf(
+				"Stopped checking status of %s, refresh artifacts to check it", msg.Artifact.Name))
+		}
+
+		return tea.Batch(
+			statusbar.StatusMessageCmd(fmt.Sprintf("%s is %s…", msg.Artifact.Name, progress(msg))),
+			tea.Tick(deploymentPollInterval, func(time.Time) tea.Msg {
+				return pollDeploymentCmd(msg)()
+			}),
+		)
+	}
+
+	// Runtime status of all listed artifacts is refreshed to reflect the deployment.
+	cmds := []tea.Cmd{model.runtimeLoader.Load(runtimeArtifacts)}
+
+	switch {
+	case msg.Error != "":
+		action := "deployment"
+		if msg.Undeploy {
+			action = "undeployment"
+		}
+
+		cmds = append(cmds, func() tea.Msg {
+			return err.ErrorMsg{
+				Err:    fmt.Errorf("%s of %s failed: %s", action, msg.Artifact.Name, msg.Error),
+				Source: DeployErrorSource,
+			}
+		})
+
+	case msg.timedOut:
+		cmds = append(cmds, statusbar.StatusMessageCmd(fmt.Sprintf(
+			"%s is still %s, refresh artifacts to check its status", msg.Artifact.Name, progress(msg))))
+
+	case msg.Undeploy:
+		cmds = append(cmds, statusbar.StatusMessageCmd(fmt.Sprintf("Undeployed %s", msg.Artifact.Name)))
+
+	default:
+		cmds = append(cmds, statusbar.StatusMessageCmd(fmt.Sprintf("Deployed %s, runtime status %s",
+			msg.Artifact.Name, msg.Status)))
+	}
+
+	return tea.Batch(cmds...)
+}
+
+// pollDeploymentCmd checks the status of the build and deploy task, if any, and then the status
+// of the artifact in the runtime.
+func pollDeploymentCmd(msg DeploymentMsg) tea.Cmd {
+	var cmd tea.Cmd
+
+	cmd = func() tea.Msg {
+		ctx := msg.ctx
+		msg.polls++
+
+		if msg.TaskID != "" {
+			status, e := api.DeploymentStatus(ctx, msg.TaskID)
+			if e != nil {
+				if ctx.Err() != nil {
+					return msg
+				}
+
+				return err.ErrorMsg{Err: e, Source: DeployErrorSource, Retry: cmd, Context: ctx}
+			}
+
+			switch status.Status {
+			case api.BuildAndDeployStatusSuccess:
+				msg.TaskID = ""
+			case api.BuildAndDeployStatusFail:
+				msg.Done = true
+				msg.Error = runtimeError(ctx, msg.Artifact.ID, "build failed")
+
+				return msg
+			default:
+				return pollingMsg(msg)
+			}
+		}
+
+		runtimeArtifact, e := api.IntegrationRuntimeArtifactByID(ctx, msg.Artifact.ID)
+
+		var apiErr *api.Error
+		if e != nil && !(errors.As(e, &apiErr) && apiErr.IsNotFound()) {
+			if ctx.Err() != nil {
+				return msg
+			}
+
+			return err.ErrorMsg{Err: e, Source: DeployErrorSource, Retry: cmd, Context: ctx}
+		}
+
+		switch {
+		case msg.Undeploy && runtimeArtifact == nil:
+			msg.Done = true
+		case msg.Undeploy, runtimeArtifact == nil, !deployedSince(*runtimeArtifact, msg.previous):
+			// The artifact is still being undeployed, or the deployed artifact hasn't reached the runtime yet
+			// and the runtime still runs the artifact that was there before.
+		case runtimeArtifact.Status == api.RuntimeStatusError:
+			msg.Done = true
+			msg.Status = runtimeArtifact.Status
+			msg.Error = runtimeError(ctx, msg.Artifact.ID, "runtime status "+runtimeArtifact.Status)
+		case runtimeArtifact.Status != api.RuntimeStatusStarting:
+			msg.Done = true
+			msg.Status = runtimeArtifact.Status
+		default:
+			msg.Status = runtimeArtifact.Status
+		}
+
+		return pollingMsg(msg)
+	}
+
+	return cmd
+}
+
+// deploymentErrorMsg reports the error of deploying or undeploying the artifact, unless the deployment has been
+// cancelled, in which case it's reported as stopped.
+func deploymentErrorMsg(ctx context.Context, e error, msg DeploymentMsg) tea.Msg {
+	if ctx.Err() != nil {
+		msg.ctx = ctx
+
+		return msg
+	}
+
+	return err.ErrorMsg{Err: e, Source: DeployErrorSource}
+}
+
+// deployedSince reports whether the artifact in the runtime was deployed after the previous deployment,
+// if there was one.
+func deployedSince(runtimeArtifact api.IntegrationRuntimeArtifact, previous time.Time) bool {
+	return previous.IsZero() || runtimeArtifact.DeployedOn.After(previous)
+}
+
+// pollingMsg gives up polling the deployment that is still in progress once the limit is reached.
+func pollingMsg(msg DeploymentMsg) tea.Msg {
+	if !msg.Done && msg.polls >= maxDeploymentPolls {
+		msg.Done = true
+		msg.timedOut = true
+	}
+
+	return msg
+}
+
+func progress(msg DeploymentMsg) string {
+	switch {
+	case msg.Undeploy:
+		return "being undeployed"
+	case msg.TaskID != "":
+		return "being built"
+	case msg.Status != "":
+		return "being deployed, runtime status " + msg.Status
+	default:
+		return "being deployed"
+	}
+}
+
+// runtimeError fetches the error text of the artifact in the runtime. If the text isn't available,
+// the fallback is returned.
+func runtimeError(ctx context.Context, artifactID, fallback string) string {
+	text, e := api.IntegrationRuntimeArtifactErrorInformation(ctx, artifactID)
+	if e != nil || text == "" {
+		return fallback
+	}
+
+	return text
+}
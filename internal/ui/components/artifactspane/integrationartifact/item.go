@@ -7,19 +7,25 @@ import (
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/muesli/reflow/truncate"
 	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/filter"
 )
 
-type Item api.IntegrationArtifact
+// Item is a design-time integration artifact joined with its deployment to the runtime, if any.
+// RuntimeKnown is false until runtime artifacts are loaded.
+type Item struct {
+	api.IntegrationArtifact
+	Runtime      *api.IntegrationRuntimeArtifact
+	RuntimeKnown bool
+}
 
 type ItemDelegate struct {
 	common common.Common
 }
 
 func (item Item) FilterValue() string {
-	return item.Name
+	return filter.Value(item.Name, item.ID)
 }
 
 func NewIntegrationArtifactItemDelegate() ItemDelegate {
@@ -50,7 +56,54 @@ func (itemDelegate ItemDelegate) Render(writer io.Writer, model list.Model, inde
 		style = itemDelegate.common.Styles.IntegrationArtifactsPane.Dataset.Item.Normal
 	}
 
-	width := model.Width() - style.GetHorizontalFrameSize()
-	content := truncate.StringWithTail(item.Name, uint(width), "…")
-	fmt.Fprint(writer, style.Render(content))
+	style = style.Width(model.Width()).MaxWidth(model.Width())
+	badge := itemDelegate.badge(item, style, index == model.Index())
+	width := model.Width() - style.GetHorizontalFrameSize() - lipgloss.Width(badge)
+	fmt.Fprint(writer, badge+filter.Render(item.Name, width, model.MatchesForItem(index), style.Width(width),
+		itemDelegate.common.Styles.IntegrationArtifactsPane.Dataset.Item.Match))
+}
+
+// badge renders the runtime status of the artifact and marks artifacts whose deployed version differs
+// from the design-time version.
+func (itemDelegate ItemDelegate) badge(item Item, style lipgloss.Style, selected bool) string {
+	if !item.RuntimeKnown {
+		return ""
+	}
+
+	styles := itemDelegate.common.Styles.IntegrationArtifactsPane.Dataset.Item
+
+	var status lipgloss.Style
+
+	switch {
+	case item.Runtime == nil:
+		status = styles.Status.Undeployed
+	case item.Runtime.Status == api.RuntimeStatusStarted:
+		status = styles.Status.Started
+	case item.Runtime.Status == api.RuntimeStatusError:
+		status = styles.Status.Error
+	default:
+		status = styles.Status.Pending
+	}
+
+	cell := lipgloss.NewStyle().
+		Background(style.GetBackground()).
+		Foreground(style.GetForeground())
+
+	outdated := cell.SetString(" ")
+	if item.Outdated() {
+		outdated = styles.Outdated
+	}
+
+	// The badge of the selected item takes the colours of the selection.
+	if selected {
+		status = cell.SetString(status.Value())
+		outdated = cell.SetString(outdated.Value())
+	}
+
+	return status.Inherit(cell).String() + outdated.Inherit(cell).String() + cell.Render(" ")
+}
+
+// Outdated reports whether the deployed version of the artifact differs from its design-time version.
+func (item Item) Outdated() bool {
+	return item.Runtime != nil && item.Runtime.Version != item.Version
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
 	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
 )
@@ -67,6 +68,18 @@ func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return model, tea.Batch(cmds...)
 }
 
+// SetWidth distributes the available width evenly between tabs.
+func (model *Model) SetWidth(width int) {
+	const maxTabWidth = 18
+
+	tabsStyles := &model.common.Styles.IntegrationArtifactsPane.Tabs
+	separatorsWidth := lipgloss.Width(tabsStyles.Tab.Separator.String()) * (len(model.tabs) - 1)
+	tabWidth := min(maxTabWidth, max(1, (width-separatorsWidth)/len(model.tabs)))
+
+	tabsStyles.Tab.Inactive = tabsStyles.Tab.Inactive.Width(tabWidth).MaxWidth(tabWidth)
+	tabsStyles.Tab.Active = tabsStyles.Tab.Active.Width(tabWidth).MaxWidth(tabWidth)
+}
+
 func (model *Model) View() string {
 	var (
 		style   lipgloss.Style
@@ -80,7 +93,8 @@ func (model *Model) View() string {
 			style = model.common.Styles.IntegrationArtifactsPane.Tabs.Tab.Inactive
 		}
 
-		builder.WriteString(style.Render(tab.Label))
+		label := truncate.StringWithTail(tab.Label, uint(style.GetWidth()), "…")
+		builder.WriteString(style.Render(label))
 
 		if idx != (len(model.tabs) - 1) {
 			builder.WriteString(model.common.Styles.IntegrationArtifactsPane.Tabs.Tab.Separator.String())
@@ -62,6 +62,15 @@ func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return model, tea.Batch(cmds...)
 }
 
+// SetSize fits attribute values into the available width and limits the number of displayed attributes.
+func (model *Model) SetSize(width, height int) {
+	attributeStyles := &model.common.Styles.AttributesPane.Attribute
+	valueWidth := max(1, width-attributeStyles.Key.GetWidth())
+
+	attributeStyles.Value = attributeStyles.Value.Width(valueWidth).MaxWidth(valueWidth)
+	model.attributes.Height(height)
+}
+
 func (model *Model) View() string {
 	return model.attributes.Render()
 }
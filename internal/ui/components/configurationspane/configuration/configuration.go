@@ -0,0 +1,401 @@
+package configuration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/config"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/pane"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
+)
+
+// Model lists externalized parameters of an integration flow. In write mode, values of parameters
+// can be edited and saved to the tenant.
+type Model struct {
+	common       common.Common
+	parameters   list.Model
+	input        textinput.Model
+	loader       pane.Loader
+	spinner      spinner.Model
+	editing      bool
+	saving       bool
+	artifactID   string
+	artifactName string
+	width        int
+}
+
+const (
+	// ErrorSource identifies errors of commands that load externalized parameters.
+	ErrorSource = "configurations"
+	// SaveErrorSource identifies errors of commands that save externalized parameters.
+	SaveErrorSource = "configuration"
+)
+
+type (
+	ConfigurationsMsg struct {
+		ArtifactID     string
+		Configurations []api.Configuration
+		ctx            context.Context
+	}
+	SavedMsg struct {
+		ArtifactID    string
+		Configuration api.Configuration
+	}
+)
+
+func New() *Model {
+	common := common.New()
+	styles := common.Styles.ConfigurationsPane
+
+	parameters := list.New(make([]list.Item, 0), NewConfigurationItemDelegate(), 0, 0)
+	parameters.DisableQuitKeybindings()
+	parameters.SetShowHelp(false)
+	parameters.SetShowTitle(false)
+	parameters.SetShowPagination(false)
+	parameters.SetShowStatusBar(false)
+	parameters.SetFilteringEnabled(false)
+	parameters.SetStatusBarItemName("parameter", "parameters")
+	parameters.Styles.NoItems = styles.Dataset.NoItems
+
+	input := textinput.New()
+	input.PromptStyle = styles.Input
+	input.TextStyle = styles.Dataset.Item.Normal
+
+	return &Model{
+		common:     common,
+		parameters: parameters,
+		input:      input,
+		loader:     pane.NewLoader(ErrorSource, styles.Dataset.Loading),
+		// The spinner runs while a parameter is being saved.
+		spinner: spinner.New(
+			spinner.WithSpinner(spinner.Dot),
+			spinner.WithStyle(styles.Dataset.Loading),
+		),
+	}
+}
+
+func (*Model) Init() tea.Cmd {
+	return nil
+}
+
+func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var (
+		cmd  tea.Cmd
+		cmds = make([]tea.Cmd, 0)
+	)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		if model.editing {
+			cmds = append(cmds, model.updateInput(msg))
+
+			break
+		}
+
+		switch {
+		case key.Matches(msg, model.common.KeyMap.Up), key.Matches(msg, model.common.KeyMap.Down):
+			model.parameters, cmd = model.parameters.Update(msg)
+			cmds = append(cmds, cmd)
+
+		case key.Matches(msg, model.common.KeyMap.Enter):
+			cmds = append(cmds, model.edit())
+
+		case key.Matches(msg, model.common.KeyMap.Refresh):
+			cmds = append(cmds, model.ConfigurationsCmd())
+		}
+
+	case spinner.TickMsg:
+		cmds = append(cmds, model.loader.Update(msg))
+
+		if model.saving {
+			model.spinner, cmd = model.spinner.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+
+	case err.ErrorMsg:
+		model.loader.Update(msg)
+
+		// The value remains in the input, so that it can be corrected and saved again.
+		if msg.Source == SaveErrorSource {
+			model.saving = false
+		}
+
+	case err.RetryMsg:
+		cmds = append(cmds, model.loader.Update(msg))
+
+		if msg.Source == SaveErrorSource {
+			model.saving = true
+			model.editing = false
+			cmds = append(cmds, model.spinner.Tick)
+		}
+
+	case ConfigurationsMsg:
+		// The parameters belong to a load that has been superseded by a newer one.
+		if !model.loader.Done(msg.ctx) {
+			break
+		}
+
+		items := make([]list.Item, 0, len(msg.Configurations))
+		for _, configuration := range msg.Configurations {
+			items = append(items, Item(configuration))
+		}
+
+		cmds = append(cmds,
+			statusbar.StatusMessageCmd(fmt.Sprintf("Loaded %d parameters in %d ms",
+				len(msg.Configurations), model.loader.Elapsed().Milliseconds())),
+			statusbar.RefreshedCmd("Configurations", time.Now()),
+			model.parameters.SetItems(items),
+		)
+
+	case SavedMsg:
+		model.saving = false
+		model.editing = false
+		model.input.Blur()
+
+		if msg.ArtifactID != model.artifactID {
+			break
+		}
+
+		for idx, item := range model.parameters.Items() {
+			if item.(Item).ParameterKey == msg.Configuration.ParameterKey {
+				cmds = append(cmds, model.parameters.SetItem(idx, Item(msg.Configuration)))
+			}
+		}
+
+		cmds = append(cmds, statusbar.StatusMessageCmd(fmt.Sprintf("Saved parameter %s of %s",
+			msg.Configuration.ParameterKey, model.artifactName)))
+
+	default:
+		if model.editing {
+			model.input, cmd = model.input.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+	}
+
+	return model, tea.Batch(cmds...)
+}
+
+// updateInput handles keys while the value of the selected parameter is being edited.
+func (model *Model) updateInput(msg tea.KeyMsg) tea.Cmd {
+	var cmd tea.Cmd
+
+	switch {
+	case key.Matches(msg, model.common.KeyMap.Close):
+		model.editing = false
+		model.input.Blur()
+
+	case key.Matches(msg, model.common.KeyMap.Enter):
+		configuration := model.SelectedConfiguration()
+		if configuration == nil || model.saving {
+			break
+		}
+
+		configuration.ParameterValue = model.input.Value()
+
+		if e := validate(*configuration); e != nil {
+			return statusbar.StatusMessageCmd("Invalid value: " + e.Error())
+		}
+
+		cmd = model.saveCmd(*configuration)
+
+	default:
+		model.input, cmd = model.input.Update(msg)
+	}
+
+	return cmd
+}
+
+// edit starts editing the value of the selected parameter, which is only allowed in write mode.
+func (model *Model) edit() tea.Cmd {
+	configuration := model.SelectedConfiguration()
+	if configuration == nil {
+		return nil
+	}
+
+	if !config.TenantWriteMode() {
+		return statusbar.StatusMessageCmd("Parameters are read-only, enable write mode in the configuration to edit them")
+	}
+
+	model.editing = true
+	model.input.Prompt = configuration.ParameterKey + ": "
+	model.input.Width = max(1, model.width-lipgloss.Width(model.input.Prompt)-1)
+	model.input.SetValue(configuration.ParameterValue)
+	model.input.CursorEnd()
+
+	return model.input.Focus()
+}
+
+// SetSize resizes the pane, including its border.
+func (model *Model) SetSize(width, height int) {
+	styles := model.common.Styles.ConfigurationsPane
+
+	model.width = max(1, width-styles.Area.GetHorizontalFrameSize())
+	model.common.Styles.ConfigurationsPane.Area = styles.Area.
+		Width(model.width).
+		Height(max(0, height-styles.Area.GetVerticalFrameSize()))
+	model.common.Styles.ConfigurationsPane.Title = styles.Title.Width(model.width)
+
+	// The title, the mode, the table header and the footer surround the list.
+	const reservedLines = 5
+
+	model.parameters.SetSize(model.width, max(1, height-styles.Area.GetVerticalFrameSize()-reservedLines))
+	model.parameters.Styles.NoItems = model.parameters.Styles.NoItems.Width(model.width)
+	model.input.Width = max(1, model.width-lipgloss.Width(model.input.Prompt)-1)
+}
+
+func (model *Model) View() string {
+	styles := model.common.Styles.ConfigurationsPane
+
+	content := model.loader.View(model.parameters, "Loading parameters…")
+
+	mode := "Read-only, enable write mode in the configuration to edit parameters"
+	if config.TenantWriteMode() {
+		mode = fmt.Sprintf("Write mode: press %s to edit the selected parameter", model.common.KeyMap.Enter.Help().Key)
+	}
+
+	return styles.Area.Render(lipgloss.JoinVertical(lipgloss.Left,
+		styles.Title.Render("Configurations of "+model.artifactName),
+		styles.Mode.Width(model.width).Render(ansi.Truncate(mode, model.width, "…")),
+		styles.Header.Width(model.width).Render(
+			row(model.width, styles.Header, styles.Header, "Parameter", "Value", "Data type"),
+		),
+		lipgloss.NewStyle().Height(model.parameters.Height()).Render(content),
+		styles.Footer.Width(model.width).Render(model.footerView()),
+	))
+}
+
+func (model *Model) footerView() string {
+	count := len(model.parameters.Items())
+
+	switch {
+	case model.saving:
+		return model.spinner.View() + " Saving…"
+	case model.editing:
+		return model.input.View()
+	case model.loader.Loading() && count > 0:
+		return model.loader.Spinner() + " Loading parameters…"
+	case count > 0:
+		return fmt.Sprintf("%d parameters", count)
+	default:
+		return ""
+	}
+}
+
+// Open lists externalized parameters of the integration flow.
+func (model *Model) Open(artifactID, artifactName string) tea.Cmd {
+	if artifactID != model.artifactID {
+		model.parameters.SetItems(make([]list.Item, 0))
+	}
+
+	model.artifactID = artifactID
+	model.artifactName = artifactName
+	model.editing = false
+	model.input.Blur()
+
+	return model.ConfigurationsCmd()
+}
+
+// Editing reports whether the value of a parameter is being edited, so that keys are consumed by the input.
+func (model *Model) Editing() bool {
+	return model.editing
+}
+
+// ConfigurationsCmd loads externalized parameters of the integration flow,
+// cancelling the load that is still in progress, if any.
+func (model *Model) ConfigurationsCmd() tea.Cmd {
+	artifactID := model.artifactID
+
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Fetching parameters…"),
+		model.loader.Load(func(ctx context.Context) (tea.Msg, error) {
+			configurations, e := api.Configurations(ctx, artifactID)
+			if e != nil {
+				return nil, e
+			}
+
+			return ConfigurationsMsg{
+				ArtifactID:     artifactID,
+				Configurations: configurations,
+				ctx:            ctx,
+			}, nil
+		}),
+	)
+}
+
+// CancelCmds cancels the load that is still in progress, if any. Saving is never cancelled.
+func (model *Model) CancelCmds() {
+	model.loader.Cancel()
+}
+
+func (model *Model) saveCmd(configuration api.Configuration) tea.Cmd {
+	var cmd tea.Cmd
+
+	artifactID := model.artifactID
+
+	cmd = func() tea.Msg {
+		if e := api.UpdateConfiguration(context.Background(), artifactID, configuration); e != nil {
+			return err.ErrorMsg{Err: e, Source: SaveErrorSource, Retry: cmd}
+		}
+
+		return SavedMsg{
+			ArtifactID:    artifactID,
+			Configuration: configuration,
+		}
+	}
+
+	model.saving = true
+
+	return tea.Batch(
+		model.spinner.Tick,
+		statusbar.StatusMessageCmd("Saving parameter "+configuration.ParameterKey+"…"),
+		cmd,
+	)
+}
+
+// SelectedConfiguration returns the selected externalized parameter, if any.
+func (model *Model) SelectedConfiguration() *api.Configuration {
+	selectedItem := model.parameters.SelectedItem()
+	if selectedItem == nil {
+		return nil
+	}
+
+	configuration := api.Configuration(selectedItem.(Item))
+
+	return &configuration
+}
+
+// validate checks that the value conforms to the data type of the parameter.
+func validate(configuration api.Configuration) error {
+	value := strings.TrimSpace(configuration.ParameterValue)
+
+	switch configuration.DataType {
+	case api.ConfigurationDataTypeInteger:
+		if _, e := strconv.ParseInt(value, 10, 32); e != nil {
+			return errors.New("value of " + configuration.ParameterKey + " must be an integer")
+		}
+	case api.ConfigurationDataTypeLong:
+		if _, e := strconv.ParseInt(value, 10, 64); e != nil {
+			return errors.New("value of " + configuration.ParameterKey + " must be a long integer")
+		}
+	case api.ConfigurationDataTypeBoolean:
+		if value != "true" && value != "false" {
+			return errors.New("value of " + configuration.ParameterKey + " must be true or false")
+		}
+	}
+
+	return nil
+}
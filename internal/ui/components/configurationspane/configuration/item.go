@@ -0,0 +1,88 @@
+package configuration
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/filter"
+)
+
+type Item api.Configuration
+
+type ItemDelegate struct {
+	common common.Common
+}
+
+const (
+	dataTypeColumnWidth = 16
+	columnGap           = 1
+)
+
+func (item Item) FilterValue() string {
+	return filter.Value(item.ParameterKey, item.ParameterValue)
+}
+
+func NewConfigurationItemDelegate() ItemDelegate {
+	return ItemDelegate{
+		common: common.New(),
+	}
+}
+
+func (ItemDelegate) Height() int {
+	return 1
+}
+
+func (ItemDelegate) Spacing() int {
+	return 0
+}
+
+func (ItemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd {
+	return nil
+}
+
+func (itemDelegate ItemDelegate) Render(writer io.Writer, model list.Model, index int, listItem list.Item) {
+	item := listItem.(Item)
+	styles := itemDelegate.common.Styles.ConfigurationsPane.Dataset
+
+	var style lipgloss.Style
+	if index == model.Index() {
+		style = styles.Item.Selected
+	} else {
+		style = styles.Item.Normal
+	}
+
+	cell := lipgloss.NewStyle().
+		Background(style.GetBackground()).
+		Foreground(style.GetForeground())
+
+	dataType := cell
+	if index != model.Index() {
+		dataType = styles.Item.DataType.Inherit(cell)
+	}
+
+	fmt.Fprint(writer, style.Width(model.Width()).MaxWidth(model.Width()).Render(
+		row(model.Width(), cell, dataType, item.ParameterKey, item.ParameterValue, item.DataType),
+	))
+}
+
+// row lays out the key, value and data type columns of the configurations table within the width.
+// The data type column is rendered with its own style.
+func row(width int, style, dataTypeStyle lipgloss.Style, key, value, dataType string) string {
+	remaining := max(0, width-dataTypeColumnWidth-2*columnGap)
+	keyWidth := remaining * 2 / 5
+	valueWidth := remaining - keyWidth
+	gap := style.Render(strings.Repeat(" ", columnGap))
+
+	return style.Width(keyWidth).Render(ansi.Truncate(key, keyWidth, "…")) +
+		gap +
+		style.Width(valueWidth).Render(ansi.Truncate(value, valueWidth, "…")) +
+		gap +
+		dataTypeStyle.Width(dataTypeColumnWidth).Render(ansi.Truncate(dataType, dataTypeColumnWidth, "…"))
+}
@@ -0,0 +1,90 @@
+package confirmdialog
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+)
+
+// Model is a dialog that asks to confirm an action before it is run.
+type Model struct {
+	common   common.Common
+	title    string
+	question string
+	action   tea.Cmd
+	width    int
+}
+
+type CloseMsg struct{}
+
+func New() *Model {
+	return &Model{
+		common: common.New(),
+	}
+}
+
+func (*Model) Init() tea.Cmd {
+	return nil
+}
+
+func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return model, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, model.common.KeyMap.Confirm):
+		action := model.action
+		model.action = nil
+
+		return model, tea.Batch(closeCmd, action)
+
+	case key.Matches(keyMsg, model.common.KeyMap.Cancel):
+		model.action = nil
+
+		return model, closeCmd
+	}
+
+	return model, nil
+}
+
+// SetSize sets the size of the dialog, including its border.
+func (model *Model) SetSize(width, _ int) {
+	const maxWidth = 64
+
+	model.width = min(maxWidth, width)
+}
+
+// Open asks the question. The action is run once it is confirmed.
+func (model *Model) Open(title, question string, action tea.Cmd) {
+	model.title = title
+	model.question = question
+	model.action = action
+}
+
+func (model *Model) View() string {
+	styles := model.common.Styles.ConfirmDialog
+	width := max(1, model.width-styles.Area.GetHorizontalFrameSize())
+
+	hint := fmt.Sprintf("%s confirm · %s cancel",
+		model.common.KeyMap.Confirm.Help().Key, model.common.KeyMap.Cancel.Help().Key)
+
+	return styles.Area.Width(model.width - styles.Area.GetHorizontalBorderSize()).Render(
+		lipgloss.JoinVertical(lipgloss.Left,
+			styles.Title.Render(ansi.Truncate(model.title, width, "…")),
+			"",
+			styles.Question.Width(width).Render(model.question),
+			"",
+			styles.Hint.Render(ansi.Truncate(hint, width, "…")),
+		),
+	)
+}
+
+func closeCmd() tea.Msg {
+	return CloseMsg{}
+}
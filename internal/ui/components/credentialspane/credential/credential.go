@@ -0,0 +1,306 @@
+package credential
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/filter"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/pane"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/attributespane/attribute"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
+)
+
+// Model lists user credentials, OAuth2 client credentials and secure parameters of the tenant.
+// The list is read only and holds metadata only: secret values are never read from responses.
+// Attributes of the selected credential are displayed below the list.
+type Model struct {
+	common      common.Common
+	credentials list.Model
+	attributes  *attribute.Model
+	loader      pane.Loader
+	width       int
+	// Number of lines that attributes of the selected credential take.
+	attributesHeight int
+}
+
+// ErrorSource identifies errors of commands that load credentials.
+const ErrorSource = "credentials"
+
+const (
+	maxAttributesHeight = 12
+	minAttributesHeight = 4
+)
+
+type (
+	CredentialsMsg struct {
+		Credentials []api.Credential
+		ctx         context.Context
+	}
+	FilterMatchesMsg list.FilterMatchesMsg
+)
+
+func New() *Model {
+	common := common.New()
+	styles := common.Styles.CredentialsPane
+
+	credentials := list.New(make([]list.Item, 0), NewCredentialItemDelegate(), 0, 0)
+	credentials.DisableQuitKeybindings()
+	credentials.SetShowHelp(false)
+	credentials.SetShowTitle(false)
+	credentials.SetShowPagination(false)
+	credentials.SetShowStatusBar(false)
+	credentials.SetStatusBarItemName("credential", "credentials")
+	credentials.Styles.NoItems = styles.Dataset.NoItems
+	filter.Setup(&credentials, styles.Dataset.Filter)
+
+	return &Model{
+		common:      common,
+		credentials: credentials,
+		attributes:  attribute.New(),
+		loader:      pane.NewLoader(ErrorSource, styles.Dataset.Loading),
+	}
+}
+
+func (*Model) Init() tea.Cmd {
+	return nil
+}
+
+func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var (
+		cmd  tea.Cmd
+		cmds = make([]tea.Cmd, 0)
+	)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case model.credentials.SettingFilter(),
+			key.Matches(msg, model.common.KeyMap.Up),
+			key.Matches(msg, model.common.KeyMap.Down),
+			key.Matches(msg, model.common.KeyMap.Filter),
+			key.Matches(msg, model.common.KeyMap.ClearFilter):
+			model.credentials, cmd = model.credentials.Update(msg)
+			if cmd != nil {
+				cmds = append(cmds, filter.Cmd(cmd, filterMatchesMsg))
+			}
+
+			filter.SyncView(&model.credentials)
+			model.updateAttributes()
+
+		case key.Matches(msg, model.common.KeyMap.Refresh):
+			cmds = append(cmds, model.CredentialsCmd())
+		}
+
+	case FilterMatchesMsg:
+		model.credentials, cmd = model.credentials.Update(list.FilterMatchesMsg(msg))
+		cmds = append(cmds, cmd)
+		model.updateAttributes()
+
+	case spinner.TickMsg, err.ErrorMsg, err.RetryMsg:
+		cmds = append(cmds, model.loader.Update(msg))
+
+	case CredentialsMsg:
+		if !model.loader.Done(msg.ctx) {
+			break
+		}
+
+		items := make([]list.Item, 0, len(msg.Credentials))
+		for _, credential := range sorted(msg.Credentials) {
+			items = append(items, Item(credential))
+		}
+
+		cmds = append(cmds,
+			statusbar.StatusMessageCmd(fmt.Sprintf("Loaded %d credentials in %d ms",
+				len(msg.Credentials), model.loader.Elapsed().Milliseconds())),
+			statusbar.RefreshedCmd("Credentials", time.Now()),
+			filter.Cmd(model.credentials.SetItems(items), filterMatchesMsg),
+		)
+		model.updateAttributes()
+	}
+
+	return model, tea.Batch(cmds...)
+}
+
+// SetSize resizes the pane, including its border.
+func (model *Model) SetSize(width, height int) {
+	styles := model.common.Styles.CredentialsPane
+	innerHeight := max(0, height-styles.Area.GetVerticalFrameSize())
+
+	model.width = max(1, width-styles.Area.GetHorizontalFrameSize())
+	model.common.Styles.CredentialsPane.Area = styles.Area.
+		Width(model.width).
+		Height(innerHeight)
+	model.common.Styles.CredentialsPane.Title = styles.Title.Width(model.width)
+
+	// Attributes take up to half of the height, so that attributes of OAuth2 client credentials fit.
+	model.attributesHeight = min(maxAttributesHeight, max(minAttributesHeight, innerHeight/2))
+	model.attributes.SetSize(model.width, model.attributesHeight)
+
+	// The title, the table header, the border above the attributes and the footer surround the list.
+	const reservedLines = 5
+
+	pane.FitList(&model.credentials, model.width, innerHeight-model.attributesHeight, reservedLines)
+}
+
+// Filtering reports whether the filter is being edited, in which case all keys are consumed by the filter input.
+func (model *Model) Filtering() bool {
+	return model.credentials.SettingFilter()
+}
+
+// FilterApplied reports whether the list is filtered, in which case the close key clears the filter.
+func (model *Model) FilterApplied() bool {
+	return model.credentials.FilterState() == list.FilterApplied
+}
+
+func (model *Model) View() string {
+	styles := model.common.Styles.CredentialsPane
+
+	return styles.Area.Render(lipgloss.JoinVertical(lipgloss.Left,
+		styles.Title.Render("Credentials"),
+		styles.Header.Width(model.width).Render(
+			row(model.width, styles.Header, nil, styles.Header,
+				"Name", "Kind", "User / client ID", "Token URL", "Modified by", "Modified at"),
+		),
+		lipgloss.NewStyle().Height(model.credentials.Height()).Render(model.loader.View(model.credentials, "Loading credentials…")),
+		styles.Detail.Width(model.width).
+			Border(lipgloss.NormalBorder(), true, false, false, false).
+			BorderForeground(styles.Area.GetBorderTopForeground()).
+			Height(model.attributesHeight).
+			Render(model.attributes.View()),
+		styles.Footer.Width(model.width).Render(ansi.Truncate(model.footerView(), model.width, "…")),
+	))
+}
+
+func (model *Model) footerView() string {
+	keys := model.common.KeyMap
+	count := len(model.credentials.Items())
+
+	switch {
+	case model.loader.Loading() && count > 0:
+		return model.loader.Spinner() + " Loading credentials…"
+	case count > 0:
+		counts := make(map[string]int)
+		for _, item := range model.credentials.Items() {
+			counts[item.(Item).Type]++
+		}
+
+		summary := fmt.Sprintf("%d credentials · user %d · OAuth2 client %d · secure parameter %d",
+			count, counts[api.CredentialTypeUser], counts[api.CredentialTypeOAuth2Client],
+			counts[api.CredentialTypeSecureParameter])
+
+		if model.FilterApplied() {
+			summary = fmt.Sprintf("%d of %s", len(model.credentials.VisibleItems()), summary)
+		}
+
+		return fmt.Sprintf("%s · %s filter · %s back", summary, keys.Filter.Help().Key, keys.Close.Help().Key)
+	default:
+		return ""
+	}
+}
+
+// Open lists credentials of the tenant.
+func (model *Model) Open() tea.Cmd {
+	return model.CredentialsCmd()
+}
+
+// CredentialsCmd loads credentials, cancelling the load that is still in progress, if any.
+func (model *Model) CredentialsCmd() tea.Cmd {
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Fetching credentials…"),
+		model.loader.Load(func(ctx context.Context) (tea.Msg, error) {
+			credentials, e := api.Credentials(ctx)
+
+			return CredentialsMsg{
+				Credentials: credentials,
+				ctx:         ctx,
+			}, e
+		}),
+	)
+}
+
+// CancelCmds cancels the load that is still in progress, if any.
+func (model *Model) CancelCmds() {
+	model.loader.Cancel()
+}
+
+// updateAttributes displays attributes of the selected credential.
+func (model *Model) updateAttributes() {
+	model.attributes.Update(attribute.AttributesMsg(model.SelectedCredentialAttributes()))
+}
+
+// SelectedCredentialAttributes describes the selected credential. Secret values are not part of it.
+func (model *Model) SelectedCredentialAttributes() []attribute.Attribute {
+	selectedItem := model.credentials.SelectedItem()
+	if selectedItem == nil {
+		return nil
+	}
+
+	credential := api.Credential(selectedItem.(Item))
+
+	modifiedAt := ""
+	if !credential.LastModifiedTime.IsZero() {
+		modifiedAt = credential.LastModifiedTime.UTC().Format(time.RFC3339)
+	}
+
+	attributes := []attribute.Attribute{
+		{Key: "Name", Value: credential.Name},
+		{Key: "Kind", Value: kindName(credential)},
+		{Key: "Description", Value: credential.Description},
+	}
+
+	switch credential.Type {
+	case api.CredentialTypeUser:
+		attributes = append(attributes,
+			attribute.Attribute{Key: "User", Value: credential.User},
+			attribute.Attribute{Key: "Company ID", Value: credential.CompanyID},
+		)
+
+	case api.CredentialTypeOAuth2Client:
+		attributes = append(attributes,
+			attribute.Attribute{Key: "Client ID", Value: credential.User},
+			attribute.Attribute{Key: "Token URL", Value: credential.TokenURL},
+			attribute.Attribute{Key: "Client auth", Value: credential.ClientAuthentication},
+			attribute.Attribute{Key: "Scope", Value: credential.Scope},
+			attribute.Attribute{Key: "Resource", Value: credential.Resource},
+			attribute.Attribute{Key: "Audience", Value: credential.Audience},
+		)
+	}
+
+	return append(attributes,
+		attribute.Attribute{Key: "Status", Value: credential.Status},
+		attribute.Attribute{Key: "Modified by", Value: credential.LastModifiedBy},
+		attribute.Attribute{Key: "Modified at", Value: modifiedAt},
+	)
+}
+
+func filterMatchesMsg(msg list.FilterMatchesMsg) tea.Msg {
+	return FilterMatchesMsg(msg)
+}
+
+// sorted orders credentials by name, and credentials of different types that share a name by type.
+func sorted(credentials []api.Credential) []api.Credential {
+	credentials = slices.Clone(credentials)
+
+	slices.SortStableFunc(credentials, func(a, b api.Credential) int {
+		if c := cmp.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name)); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Type, b.Type)
+	})
+
+	return credentials
+}
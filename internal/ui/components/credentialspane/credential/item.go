@@ -0,0 +1,128 @@
+package credential
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/filter"
+)
+
+type Item api.Credential
+
+type ItemDelegate struct {
+	common common.Common
+}
+
+const (
+	kindColumnWidth = 16
+	dateColumnWidth = 16
+	columnGap       = 1
+	dateTimeLayout  = "2006-01-02 15:04"
+)
+
+// Kinds of user credentials, other than the default one.
+var userKindNames = map[string]string{
+	"successfactors": "SuccessFactors",
+	"openconnectors": "Open Connectors",
+}
+
+func (item Item) FilterValue() string {
+	return filter.Value(item.Name, kindName(api.Credential(item)), item.User, item.TokenURL, item.Description)
+}
+
+func NewCredentialItemDelegate() ItemDelegate {
+	return ItemDelegate{
+		common: common.New(),
+	}
+}
+
+func (ItemDelegate) Height() int {
+	return 1
+}
+
+func (ItemDelegate) Spacing() int {
+	return 0
+}
+
+func (ItemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd {
+	return nil
+}
+
+func (itemDelegate ItemDelegate) Render(writer io.Writer, model list.Model, index int, listItem list.Item) {
+	item := listItem.(Item)
+	styles := itemDelegate.common.Styles.CredentialsPane.Dataset
+
+	style := styles.Item.Normal
+	if index == model.Index() {
+		style = styles.Item.Selected
+	}
+
+	cell := lipgloss.NewStyle().
+		Background(style.GetBackground()).
+		Foreground(style.GetForeground())
+
+	fmt.Fprint(writer, style.Width(model.Width()).MaxWidth(model.Width()).Render(
+		row(model.Width(), cell, model.MatchesForItem(index), styles.Item.Match,
+			item.Name, kindName(api.Credential(item)), item.User, item.TokenURL,
+			item.LastModifiedBy, dateTime(item.LastModifiedTime)),
+	))
+}
+
+// row lays out the name, kind, user or client ID, token URL and last modification columns of the credentials
+// table within the width. Matches of the filter are highlighted in the name.
+func row(width int, style lipgloss.Style, matches []int, matchStyle lipgloss.Style,
+	name, kind, user, tokenURL, modifiedBy, modifiedAt string,
+) string {
+	const columns = 6
+
+	remaining := max(0, width-kindColumnWidth-dateColumnWidth-(columns-1)*columnGap)
+	nameWidth := remaining * 3 / 10
+	userWidth := remaining / 5
+	modifiedByWidth := remaining / 5
+	tokenURLWidth := remaining - nameWidth - userWidth - modifiedByWidth
+	gap := style.Render(strings.Repeat(" ", columnGap))
+
+	cell := func(value string, width int) string {
+		return style.Width(width).Render(ansi.Truncate(value, width, "…"))
+	}
+
+	return filter.Render(name, nameWidth, matches, style.Width(nameWidth), matchStyle) + gap +
+		cell(kind, kindColumnWidth) + gap +
+		cell(user, userWidth) + gap +
+		cell(tokenURL, tokenURLWidth) + gap +
+		cell(modifiedBy, modifiedByWidth) + gap +
+		cell(modifiedAt, dateColumnWidth)
+}
+
+// kindName describes the type of the credential, e.g. User, SuccessFactors or OAuth2 client.
+func kindName(credential api.Credential) string {
+	switch credential.Type {
+	case api.CredentialTypeUser:
+		if name, ok := userKindNames[credential.Kind]; ok {
+			return name
+		}
+
+		return "User"
+	case api.CredentialTypeOAuth2Client:
+		return "OAuth2 client"
+	case api.CredentialTypeSecureParameter:
+		return "Secure parameter"
+	default:
+		return credential.Type
+	}
+}
+
+func dateTime(value api.DateTime) string {
+	if value.IsZero() {
+		return ""
+	}
+
+	return value.UTC().Format(dateTimeLayout)
+}
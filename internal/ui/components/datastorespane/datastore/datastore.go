@@ -0,0 +1,541 @@
+package datastore
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/config"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/pane"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/tools/file"
+)
+
+// Model lists data stores of the runtime and, once a data store is opened, its entries. Payloads of entries
+// can be downloaded as zip files, and entries can be deleted in write mode.
+type Model struct {
+	common  common.Common
+	stores  list.Model
+	entries list.Model
+	store   *api.DataStore
+	loader  pane.Loader
+	width   int
+
+	// Downloads of payloads run in the context of the screen and are cancelled when it's closed.
+	downloads      context.Context
+	downloadCancel context.CancelFunc
+}
+
+const (
+	// ErrorSource identifies errors of commands that load data stores and their entries.
+	ErrorSource = "datastores"
+	// DownloadErrorSource identifies errors of commands that download payloads of data store entries.
+	DownloadErrorSource = "datastore entry download"
+	// DeleteErrorSource identifies errors of commands that delete data store entries. Deletion isn't retried
+	// with the retry key, as it has to be confirmed.
+	DeleteErrorSource = "datastore entry deletion"
+)
+
+type (
+	StoresMsg struct {
+		Stores []api.DataStore
+		ctx    context.Context
+	}
+	EntriesMsg struct {
+		Store   api.DataStore
+		Entries []api.DataStoreEntry
+		ctx     context.Context
+	}
+	// DownloadedMsg reports the file the payload of the data store entry has been saved to.
+	DownloadedMsg struct {
+		Entry api.DataStoreEntry
+		Path  string
+	}
+	// DeletedMsg reports that the entry has been deleted from its data store.
+	DeletedMsg struct {
+		Entry   api.DataStoreEntry
+		Overdue bool
+	}
+)
+
+func New() *Model {
+	common := common.New()
+	styles := common.Styles.DataStoresPane
+
+	init := func(singular, plural string) list.Model {
+		list := list.New(make([]list.Item, 0), NewDataStoreItemDelegate(), 0, 0)
+		list.DisableQuitKeybindings()
+		list.SetShowHelp(false)
+		list.SetShowTitle(false)
+		list.SetShowPagination(false)
+		list.SetShowStatusBar(false)
+		list.SetFilteringEnabled(false)
+		list.SetStatusBarItemName(singular, plural)
+		list.Styles.NoItems = styles.Dataset.NoItems
+
+		return list
+	}
+
+	return &Model{
+		common:  common,
+		stores:  init("data store", "data stores"),
+		entries: init("entry", "entries"),
+		loader:  pane.NewLoader(ErrorSource, styles.Dataset.Loading),
+	}
+}
+
+func (*Model) Init() tea.Cmd {
+	return nil
+}
+
+func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var (
+		cmd  tea.Cmd
+		cmds = make([]tea.Cmd, 0)
+	)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, model.common.KeyMap.Up), key.Matches(msg, model.common.KeyMap.Down):
+			dataset := model.activeList()
+			*dataset, cmd = dataset.Update(msg)
+			cmds = append(cmds, cmd)
+
+		case key.Matches(msg, model.common.KeyMap.Enter):
+			if store := model.selectedStore(); model.store == nil && store != nil {
+				cmds = append(cmds, model.EntriesCmd(*store))
+			}
+
+		case key.Matches(msg, model.common.KeyMap.Save):
+			cmds = append(cmds, model.downloadCmd())
+
+		case key.Matches(msg, model.common.KeyMap.Refresh):
+			if model.store != nil {
+				cmds = append(cmds, model.EntriesCmd(*model.store))
+			} else {
+				cmds = append(cmds, model.StoresCmd())
+			}
+		}
+
+	case spinner.TickMsg, err.ErrorMsg, err.RetryMsg:
+		cmds = append(cmds, model.loader.Update(msg))
+
+	case StoresMsg:
+		if !model.loader.Done(msg.ctx) {
+			break
+		}
+
+		items := make([]list.Item, 0, len(msg.Stores))
+		for _, store := range sortedStores(msg.Stores) {
+			items = append(items, StoreItem{DataStore: store, Retention: model.retention(store)})
+		}
+
+		cmds = append(cmds,
+			statusbar.StatusMessageCmd(fmt.Sprintf("Loaded %d data stores in %d ms",
+				len(msg.Stores), model.loader.Elapsed().Milliseconds())),
+			statusbar.RefreshedCmd("Data stores", time.Now()),
+			model.stores.SetItems(items),
+		)
+
+	case EntriesMsg:
+		if !model.loader.Done(msg.ctx) {
+			break
+		}
+
+		items := make([]list.Item, 0, len(msg.Entries))
+		for _, entry := range sortedEntries(msg.Entries) {
+			items = append(items, EntryItem(entry))
+		}
+
+		model.updateStore(msg.Store, func(item *StoreItem) {
+			item.NumberOfMessages = len(msg.Entries)
+			item.NumberOfOverdueMessages = countOverdue(msg.Entries, time.Now())
+			item.Retention = retentionOf(msg.Entries)
+		})
+
+		cmds = append(cmds,
+			statusbar.StatusMessageCmd(fmt.Sprintf("Loaded %d entries of data store %s in %d ms",
+				len(msg.Entries), msg.Store.DataStoreName, model.loader.Elapsed().Milliseconds())),
+			model.entries.SetItems(items),
+		)
+
+	case DownloadedMsg:
+		cmds = append(cmds, statusbar.StatusMessageCmd(fmt.Sprintf("Downloaded entry %s of data store %s to %s",
+			msg.Entry.ID, msg.Entry.DataStoreName, msg.Path)))
+
+	case DeletedMsg:
+		if idx := slices.IndexFunc(model.entries.Items(), func(item list.Item) bool {
+			return item.(EntryItem).ID == msg.Entry.ID
+		}); idx != -1 && model.store != nil && sameStore(*model.store, storeOf(msg.Entry)) {
+			model.entries.RemoveItem(idx)
+		}
+
+		model.updateStore(storeOf(msg.Entry), func(item *StoreItem) {
+			item.NumberOfMessages = max(0, item.NumberOfMessages-1)
+			if msg.Overdue {
+				item.NumberOfOverdueMessages = max(0, item.NumberOfOverdueMessages-1)
+			}
+		})
+
+		cmds = append(cmds, statusbar.StatusMessageCmd(fmt.Sprintf("Deleted entry %s of data store %s",
+			msg.Entry.ID, msg.Entry.DataStoreName)))
+	}
+
+	return model, tea.Batch(cmds...)
+}
+
+// SetSize resizes the pane, including its border.
+func (model *Model) SetSize(width, height int) {
+	styles := model.common.Styles.DataStoresPane
+
+	model.width = max(1, width-styles.Area.GetHorizontalFrameSize())
+	model.common.Styles.DataStoresPane.Area = styles.Area.
+		Width(model.width).
+		Height(max(0, height-styles.Area.GetVerticalFrameSize()))
+	model.common.Styles.DataStoresPane.Title = styles.Title.Width(model.width)
+
+	// The title, the table header and the footer surround the list.
+	const reservedLines = 4
+
+	for _, dataset := range []*list.Model{&model.stores, &model.entries} {
+		pane.FitList(dataset, model.width, height-styles.Area.GetVerticalFrameSize(), reservedLines)
+	}
+}
+
+func (model *Model) View() string {
+	styles := model.common.Styles.DataStoresPane
+	dataset := model.activeList()
+
+	var (
+		title, header string
+		loading       = "Loading data stores…"
+	)
+
+	if model.store != nil {
+		title = "Entries of data store " + model.store.DataStoreName
+		if model.store.IntegrationFlow != "" {
+			title += " of " + model.store.IntegrationFlow
+		}
+
+		header = entryRow(model.width, styles.Header,
+			"Entry ID", "Message ID", "Status", "Created at", "Due at", "Retain until")
+		loading = "Loading entries…"
+	} else {
+		title = "Data stores"
+		header = storeRow(model.width, styles.Header,
+			"Data store", "Integration flow", "Visibility", "Entries", "Overdue", "Retention")
+	}
+
+	return styles.Area.Render(lipgloss.JoinVertical(lipgloss.Left,
+		styles.Title.Render(ansi.Truncate(title, model.width, "…")),
+		styles.Header.Width(model.width).Render(header),
+		lipgloss.NewStyle().Height(dataset.Height()).Render(model.loader.View(*dataset, loading)),
+		styles.Footer.Width(model.width).Render(ansi.Truncate(model.footerView(), model.width, "…")),
+	))
+}
+
+func (model *Model) footerView() string {
+	keys := model.common.KeyMap
+
+	switch {
+	case model.loader.Loading() && len(model.activeList().Items()) > 0:
+		return model.loader.Spinner() + " Loading…"
+
+	case model.store != nil && len(model.entries.Items()) > 0:
+		entries := make([]api.DataStoreEntry, 0, len(model.entries.Items()))
+		for _, item := range model.entries.Items() {
+			entries = append(entries, api.DataStoreEntry(item.(EntryItem)))
+		}
+
+		footer := fmt.Sprintf("%d entries · %d overdue", len(entries), countOverdue(entries, time.Now()))
+		if retention := retention(retentionOf(entries)); retention != "" {
+			footer += " · retained for " + retention
+		}
+
+		footer += fmt.Sprintf(" · %s download payload", keys.Save.Help().Key)
+		if config.TenantWriteMode() {
+			footer += fmt.Sprintf(" · %s delete entry", keys.Delete.Help().Key)
+		}
+
+		return footer + fmt.Sprintf(" · %s back", keys.Close.Help().Key)
+
+	case model.store == nil && len(model.stores.Items()) > 0:
+		entries, overdue := 0, 0
+
+		for _, item := range model.stores.Items() {
+			entries += item.(StoreItem).NumberOfMessages
+			overdue += item.(StoreItem).NumberOfOverdueMessages
+		}
+
+		return fmt.Sprintf("%d data stores · %d entries · %d overdue · %s entries of data store · %s back",
+			len(model.stores.Items()), entries, overdue, keys.Enter.Help().Key, keys.Close.Help().Key)
+
+	default:
+		return ""
+	}
+}
+
+// Open lists data stores of the runtime.
+func (model *Model) Open() tea.Cmd {
+	model.store = nil
+
+	return model.StoresCmd()
+}
+
+// Back returns from entries of the data store to the list of data stores. It reports whether there was
+// a data store to return from.
+func (model *Model) Back() bool {
+	if model.store == nil {
+		return false
+	}
+
+	model.loader.Reset()
+	model.store = nil
+
+	return true
+}
+
+// StoresCmd loads data stores, cancelling the load that is still in progress, if any.
+func (model *Model) StoresCmd() tea.Cmd {
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Fetching data stores…"),
+		model.loader.Load(func(ctx context.Context) (tea.Msg, error) {
+			stores, e := api.DataStores(ctx)
+
+			return StoresMsg{
+				Stores: stores,
+				ctx:    ctx,
+			}, e
+		}),
+	)
+}
+
+// EntriesCmd opens the data store and loads its entries, cancelling the load that is still in progress, if any.
+func (model *Model) EntriesCmd(store api.DataStore) tea.Cmd {
+	if model.store == nil || !sameStore(*model.store, store) {
+		model.entries.SetItems(nil)
+		model.entries.ResetSelected()
+	}
+
+	model.store = &store
+
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Fetching entries of data store "+store.DataStoreName+"…"),
+		model.loader.Load(func(ctx context.Context) (tea.Msg, error) {
+			entries, e := api.DataStoreEntries(ctx, store)
+
+			return EntriesMsg{
+				Store:   store,
+				Entries: entries,
+				ctx:     ctx,
+			}, e
+		}),
+	)
+}
+
+// CancelCmds cancels the load and downloads that are still in progress, if any.
+func (model *Model) CancelCmds() {
+	model.loader.Cancel()
+
+	if model.downloadCancel != nil {
+		model.downloadCancel()
+		model.downloads, model.downloadCancel = nil, nil
+	}
+}
+
+// DeleteCmd deletes the selected entry from its data store.
+func (model *Model) DeleteCmd() tea.Cmd {
+	entry := model.SelectedEntry()
+	if entry == nil {
+		return nil
+	}
+
+	overdue := isOverdue(*entry, time.Now())
+
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Deleting entry "+entry.ID+"…"),
+		func() tea.Msg {
+			if e := api.DeleteDataStoreEntry(context.Background(), *entry); e != nil {
+				return err.ErrorMsg{Err: e, Source: DeleteErrorSource}
+			}
+
+			return DeletedMsg{Entry: *entry, Overdue: overdue}
+		},
+	)
+}
+
+// downloadCmd saves the payload of the selected entry as a zip file in the download directory.
+// The file is named after the data store and the entry and is never overwritten. The download is cancelled
+// when the screen is closed.
+func (model *Model) downloadCmd() tea.Cmd {
+	entry := model.SelectedEntry()
+	if entry == nil {
+		return nil
+	}
+
+	if model.downloads == nil {
+		model.downloads, model.downloadCancel = context.WithCancel(context.Background())
+	}
+
+	ctx := model.downloads
+
+	var cmd tea.Cmd
+
+	cmd = func() tea.Msg {
+		directory := config.DownloadDirectory()
+		if e := os.MkdirAll(directory, 0o750); e != nil {
+			return err.ErrorMsg{Err: fmt.Errorf("error downloading data store entry %s: %w", entry.ID, e),
+				Source: DownloadErrorSource}
+		}
+
+		output, path, e := file.CreateUnique(directory, file.SafeName(entry.DataStoreName+"_"+entry.ID), ".zip")
+		if e != nil {
+			return err.ErrorMsg{Err: fmt.Errorf("error downloading data store entry %s: %w", entry.ID, e),
+				Source: DownloadErrorSource}
+		}
+
+		e = api.DownloadDataStoreEntry(ctx, *entry, output)
+		if closeErr := output.Close(); e == nil {
+			e = closeErr
+		}
+
+		if e != nil {
+			_ = os.Remove(path)
+
+			if ctx.Err() != nil {
+				return statusbar.StatusMsg("Cancelled download of entry " + entry.ID)
+			}
+
+			return err.ErrorMsg{Err: e, Source: DownloadErrorSource, Retry: cmd, Context: ctx}
+		}
+
+		return DownloadedMsg{Entry: *entry, Path: path}
+	}
+
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Downloading entry "+entry.ID+"…"),
+		cmd,
+	)
+}
+
+func (model *Model) activeList() *list.Model {
+	if model.store != nil {
+		return &model.entries
+	}
+
+	return &model.stores
+}
+
+func (model *Model) selectedStore() *api.DataStore {
+	selectedItem := model.stores.SelectedItem()
+	if selectedItem == nil {
+		return nil
+	}
+
+	store := selectedItem.(StoreItem).DataStore
+
+	return &store
+}
+
+// SelectedEntry returns the entry that is selected in the opened data store, if any.
+func (model *Model) SelectedEntry() *api.DataStoreEntry {
+	if model.store == nil {
+		return nil
+	}
+
+	selectedItem := model.entries.SelectedItem()
+	if selectedItem == nil {
+		return nil
+	}
+
+	entry := api.DataStoreEntry(selectedItem.(EntryItem))
+
+	return &entry
+}
+
+// updateStore applies the change to the data store in the list of data stores, if it is there.
+func (model *Model) updateStore(store api.DataStore, change func(item *StoreItem)) {
+	for idx, listItem := range model.stores.Items() {
+		item := listItem.(StoreItem)
+		if sameStore(item.DataStore, store) {
+			change(&item)
+			model.stores.SetItem(idx, item)
+
+			return
+		}
+	}
+}
+
+// retention returns the retention of the data store that is already listed, if known, so that a refresh keeps it.
+func (model *Model) retention(store api.DataStore) time.Duration {
+	for _, listItem := range model.stores.Items() {
+		if item := listItem.(StoreItem); sameStore(item.DataStore, store) {
+			return item.Retention
+		}
+	}
+
+	return 0
+}
+
+func sameStore(a, b api.DataStore) bool {
+	return a.DataStoreName == b.DataStoreName && a.IntegrationFlow == b.IntegrationFlow && a.Type == b.Type
+}
+
+func storeOf(entry api.DataStoreEntry) api.DataStore {
+	return api.DataStore{
+		DataStoreName:   entry.DataStoreName,
+		IntegrationFlow: entry.IntegrationFlow,
+		Type:            entry.Type,
+	}
+}
+
+func countOverdue(entries []api.DataStoreEntry, now time.Time) int {
+	count := 0
+
+	for _, entry := range entries {
+		if isOverdue(entry, now) {
+			count++
+		}
+	}
+
+	return count
+}
+
+// sortedStores orders data stores by name, and data stores that share a name by integration flow.
+func sortedStores(stores []api.DataStore) []api.DataStore {
+	stores = slices.Clone(stores)
+
+	slices.SortStableFunc(stores, func(a, b api.DataStore) int {
+		if c := cmp.Compare(a.DataStoreName, b.DataStoreName); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.IntegrationFlow, b.IntegrationFlow)
+	})
+
+	return stores
+}
+
+// sortedEntries orders entries by creation time, most recent first.
+func sortedEntries(entries []api.DataStoreEntry) []api.DataStoreEntry {
+	entries = slices.Clone(entries)
+
+	slices.SortStableFunc(entries, func(a, b api.DataStoreEntry) int {
+		return b.CreatedAt.Compare(a.CreatedAt.Time)
+	})
+
+	return entries
+}
@@ -0,0 +1,227 @@
+package datastore
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+)
+
+type (
+	// StoreItem is a data store. Retention is unknown until entries of the data store are loaded.
+	StoreItem struct {
+		api.DataStore
+
+		Retention time.Duration
+	}
+	EntryItem api.DataStoreEntry
+)
+
+type ItemDelegate struct {
+	common common.Common
+}
+
+const (
+	visibilityColumnWidth = 16
+	countColumnWidth      = 8
+	retentionColumnWidth  = 10
+	statusColumnWidth     = 12
+	dateColumnWidth       = 16
+	columnGap             = 1
+	dateTimeLayout        = "2006-01-02 15:04"
+	hoursPerDay           = 24
+)
+
+func (item StoreItem) FilterValue() string {
+	return item.DataStoreName
+}
+
+func (item EntryItem) FilterValue() string {
+	return item.ID
+}
+
+func NewDataStoreItemDelegate() ItemDelegate {
+	return ItemDelegate{
+		common: common.New(),
+	}
+}
+
+func (ItemDelegate) Height() int {
+	return 1
+}
+
+func (ItemDelegate) Spacing() int {
+	return 0
+}
+
+func (ItemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd {
+	return nil
+}
+
+func (itemDelegate ItemDelegate) Render(writer io.Writer, model list.Model, index int, listItem list.Item) {
+	styles := itemDelegate.common.Styles.DataStoresPane.Dataset
+
+	var style lipgloss.Style
+
+	switch {
+	case index == model.Index():
+		style = styles.Item.Selected
+	case overdue(listItem, time.Now()):
+		style = styles.Item.Overdue
+	default:
+		style = styles.Item.Normal
+	}
+
+	cell := lipgloss.NewStyle().
+		Background(style.GetBackground()).
+		Foreground(style.GetForeground())
+
+	var line string
+
+	switch item := listItem.(type) {
+	case StoreItem:
+		line = storeRow(model.Width(), cell, item.DataStoreName, owner(item.DataStore), item.Visibility,
+			strconv.Itoa(item.NumberOfMessages), strconv.Itoa(item.NumberOfOverdueMessages), retention(item.Retention))
+
+	case EntryItem:
+		line = entryRow(model.Width(), cell, item.ID, item.MessageID, item.Status,
+			dateTime(item.CreatedAt), dateTime(item.DueAt), dateTime(item.RetainUntil))
+	}
+
+	fmt.Fprint(writer, style.Width(model.Width()).MaxWidth(model.Width()).Render(line))
+}
+
+// overdue reports whether the data store has overdue entries, or whether the entry is overdue.
+func overdue(listItem list.Item, now time.Time) bool {
+	switch item := listItem.(type) {
+	case StoreItem:
+		return item.NumberOfOverdueMessages > 0
+	case EntryItem:
+		return isOverdue(api.DataStoreEntry(item), now)
+	default:
+		return false
+	}
+}
+
+// storeRow lays out the name, integration flow, visibility, entry counts and retention columns of the data
+// stores table within the width.
+func storeRow(width int, style lipgloss.Style, name, flow, visibility, entries, overdue, retention string) string {
+	const columns = 6
+
+	remaining := max(0, width-visibilityColumnWidth-2*countColumnWidth-retentionColumnWidth-(columns-1)*columnGap)
+	nameWidth := remaining / 2
+	flowWidth := remaining - nameWidth
+
+	return cells(style,
+		column{name, nameWidth, false},
+		column{flow, flowWidth, false},
+		column{visibility, visibilityColumnWidth, false},
+		column{entries, countColumnWidth, true},
+		column{overdue, countColumnWidth, true},
+		column{retention, retentionColumnWidth, true},
+	)
+}
+
+// entryRow lays out the ID, message ID, status and dates columns of the data store entries table within the width.
+func entryRow(width int, style lipgloss.Style, id, messageID, status, createdAt, dueAt, retainUntil string) string {
+	const columns = 6
+
+	remaining := max(0, width-statusColumnWidth-3*dateColumnWidth-(columns-1)*columnGap)
+	idWidth := remaining / 2
+	messageIDWidth := remaining - idWidth
+
+	return cells(style,
+		column{id, idWidth, false},
+		column{messageID, messageIDWidth, false},
+		column{status, statusColumnWidth, false},
+		column{createdAt, dateColumnWidth, false},
+		column{dueAt, dateColumnWidth, false},
+		column{retainUntil, dateColumnWidth, false},
+	)
+}
+
+type column struct {
+	value      string
+	width      int
+	alignRight bool
+}
+
+func cells(style lipgloss.Style, columns ...column) string {
+	rendered := make([]string, 0, len(columns))
+
+	for _, column := range columns {
+		cellStyle := style.Width(column.width)
+		if column.alignRight {
+			cellStyle = cellStyle.AlignHorizontal(lipgloss.Right)
+		}
+
+		rendered = append(rendered, cellStyle.Render(ansi.Truncate(column.value, column.width, "…")))
+	}
+
+	return strings.Join(rendered, style.Render(strings.Repeat(" ", columnGap)))
+}
+
+// owner names the integration flow that owns the data store. Global data stores are not owned by any.
+func owner(store api.DataStore) string {
+	if store.IntegrationFlow == "" {
+		return "–"
+	}
+
+	return store.IntegrationFlow
+}
+
+// isOverdue reports whether the entry hasn't been fetched from the data store by the time it was due.
+func isOverdue(entry api.DataStoreEntry, now time.Time) bool {
+	return !entry.DueAt.IsZero() && entry.DueAt.Before(now)
+}
+
+// retentionOf estimates how long the data store retains entries from its most recent entry.
+func retentionOf(entries []api.DataStoreEntry) time.Duration {
+	var latest *api.DataStoreEntry
+
+	for idx, entry := range entries {
+		if entry.RetainUntil.IsZero() || entry.CreatedAt.IsZero() {
+			continue
+		}
+
+		if latest == nil || entry.CreatedAt.After(latest.CreatedAt.Time) {
+			latest = &entries[idx]
+		}
+	}
+
+	if latest == nil {
+		return 0
+	}
+
+	return latest.RetainUntil.Sub(latest.CreatedAt.Time)
+}
+
+func retention(duration time.Duration) string {
+	if duration <= 0 {
+		return ""
+	}
+
+	days := int(math.Round(duration.Hours() / hoursPerDay))
+	if days == 1 {
+		return "1 day"
+	}
+
+	return strconv.Itoa(days) + " days"
+}
+
+func dateTime(value api.DateTime) string {
+	if value.IsZero() {
+		return ""
+	}
+
+	return value.UTC().Format(dateTimeLayout)
+}
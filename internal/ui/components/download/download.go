@@ -0,0 +1,332 @@
+package download
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/config"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/format"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/tools/file"
+)
+
+// Model asks for the directory to download the zip archive of a content package or an integration artifact to,
+// and tracks progress of the download. Only one download runs at a time. While it runs, the dialog offers
+// to cancel it instead.
+type Model struct {
+	common   common.Common
+	input    textinput.Model
+	opened   bool
+	target   Target
+	transfer *transfer
+	err      error
+	width    int
+}
+
+// Target is a content package or an integration artifact to be downloaded. ArtifactType is empty
+// for content packages.
+type Target struct {
+	ID           string
+	Name         string
+	ArtifactType string
+}
+
+// transfer is a download in progress. Its counters are updated by the command that streams the archive.
+// The download is aborted when its context is cancelled.
+type transfer struct {
+	target     Target
+	ctx        context.Context
+	cancel     context.CancelFunc
+	downloaded atomic.Int64
+	total      atomic.Int64
+}
+
+type (
+	CloseMsg      struct{}
+	DownloadedMsg struct {
+		Target   Target
+		Path     string
+		Size     int64
+		transfer *transfer
+	}
+	// CancelledMsg reports that the download has been cancelled and its incomplete file removed.
+	CancelledMsg struct {
+		Target   Target
+		transfer *transfer
+	}
+	// ProgressMsg triggers an update of progress of the download.
+	ProgressMsg struct {
+		transfer *transfer
+	}
+)
+
+// ErrorSource identifies errors of downloads.
+const ErrorSource = "download"
+
+const progressInterval = 100 * time.Millisecond
+
+func New() *Model {
+	common := common.New()
+
+	input := textinput.New()
+	input.Prompt = ""
+	input.TextStyle = common.Styles.DownloadDialog.Input
+
+	return &Model{
+		common: common,
+		input:  input,
+	}
+}
+
+func (*Model) Init() tea.Cmd {
+	return nil
+}
+
+func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, model.common.KeyMap.Close):
+			return model, closeCmd
+
+		case key.Matches(msg, model.common.KeyMap.Enter):
+			if model.transfer != nil {
+				return model, model.cancel()
+			}
+
+			return model, model.start()
+
+		case model.transfer != nil:
+			// While the download is in progress, the dialog only asks whether to cancel it.
+
+		default:
+			model.input, cmd = model.input.Update(msg)
+		}
+
+	case ProgressMsg:
+		if msg.transfer == model.transfer {
+			cmd = progressCmd(msg.transfer)
+		}
+
+	case DownloadedMsg:
+		if msg.transfer == model.transfer {
+			model.transfer = nil
+		}
+
+		cmd = statusbar.StatusMessageCmd(fmt.Sprintf("Downloaded %s to %s (%s)",
+			msg.Target.Name, msg.Path, format.Size(msg.Size)))
+
+	case CancelledMsg:
+		if msg.transfer == model.transfer {
+			model.transfer = nil
+		}
+
+		cmd = statusbar.StatusMessageCmd("Cancelled download of " + msg.Target.Name)
+
+	case err.ErrorMsg:
+		if msg.Source == ErrorSource {
+			model.transfer = nil
+		}
+
+	default:
+		model.input, cmd = model.input.Update(msg)
+	}
+
+	return model, cmd
+}
+
+// SetSize sets the size of the dialog, including its border.
+func (model *Model) SetSize(width, _ int) {
+	const maxWidth = 80
+
+	model.width = min(maxWidth, width)
+	model.input.Width = max(1, model.contentWidth()-1)
+}
+
+// Open asks for the directory to download the target to. When the dialog is opened for the first time,
+// the directory is taken from the configuration. While a download is in progress, the dialog asks
+// whether to cancel it instead.
+func (model *Model) Open(target Target) tea.Cmd {
+	if model.transfer != nil {
+		model.err = nil
+
+		return nil
+	}
+
+	if !model.opened {
+		model.opened = true
+		model.input.SetValue(config.DownloadDirectory())
+		model.input.CursorEnd()
+	}
+
+	model.target = target
+	model.err = nil
+
+	return model.input.Focus()
+}
+
+// Downloading reports whether a download is in progress.
+func (model *Model) Downloading() bool {
+	return model.transfer != nil
+}
+
+// Progress returns progress of the download to be displayed in the status bar, if a download is in progress.
+func (model *Model) Progress() *statusbar.Progress {
+	if model.transfer == nil {
+		return nil
+	}
+
+	return &statusbar.Progress{
+		Label:   "Downloading " + model.transfer.target.Name,
+		Current: model.transfer.downloaded.Load(),
+		Total:   model.transfer.total.Load(),
+	}
+}
+
+func (model *Model) View() string {
+	styles := model.common.Styles.DownloadDialog
+	width := model.contentWidth()
+
+	kind := "content package"
+	if model.target.ArtifactType != "" {
+		kind = "integration artifact"
+	}
+
+	if model.transfer != nil {
+		return styles.Area.Width(model.width - styles.Area.GetHorizontalBorderSize()).Render(
+			lipgloss.JoinVertical(lipgloss.Left,
+				styles.Title.Render(ansi.Truncate("Downloading "+model.transfer.target.Name, width, "…")),
+				"",
+				styles.Hint.Render(ansi.Truncate(fmt.Sprintf("%s cancel download · %s keep downloading",
+					model.common.KeyMap.Enter.Help().Key, model.common.KeyMap.Close.Help().Key), width, "…")),
+			),
+		)
+	}
+
+	status := styles.Hint.Render(ansi.Truncate(fmt.Sprintf("%s download · %s cancel",
+		model.common.KeyMap.Enter.Help().Key, model.common.KeyMap.Close.Help().Key), width, "…"))
+	if model.err != nil {
+		status = styles.Error.Render(ansi.Truncate(model.err.Error(), width, "…"))
+	}
+
+	return styles.Area.Width(model.width - styles.Area.GetHorizontalBorderSize()).Render(
+		lipgloss.JoinVertical(lipgloss.Left,
+			styles.Title.Render(ansi.Truncate("Download "+kind+" "+model.target.Name, width, "…")),
+			"",
+			styles.Label.Render("Directory"),
+			model.input.View(),
+			"",
+			status,
+		),
+	)
+}
+
+// start starts downloading the target to the entered directory, which is created if it doesn't exist.
+func (model *Model) start() tea.Cmd {
+	directory := strings.TrimSpace(model.input.Value())
+	if directory == "" {
+		model.err = errors.New("enter a directory")
+
+		return nil
+	}
+
+	if e := os.MkdirAll(directory, 0o750); e != nil {
+		model.err = e
+
+		return nil
+	}
+
+	model.err = nil
+	model.transfer = &transfer{target: model.target}
+	model.transfer.ctx, model.transfer.cancel = context.WithCancel(context.Background())
+	model.transfer.total.Store(-1)
+
+	return tea.Batch(
+		closeCmd,
+		downloadCmd(model.transfer, directory),
+		progressCmd(model.transfer),
+	)
+}
+
+// cancel aborts the download in progress. The download reports back with CancelledMsg once it has stopped.
+func (model *Model) cancel() tea.Cmd {
+	model.transfer.cancel()
+
+	return closeCmd
+}
+
+func (model *Model) contentWidth() int {
+	return max(1, model.width-model.common.Styles.DownloadDialog.Area.GetHorizontalFrameSize())
+}
+
+// downloadCmd streams the archive to a new file in the directory. The file is named after the target
+// and is never overwritten. An incomplete file is removed if the download fails or is cancelled.
+func downloadCmd(transfer *transfer, directory string) tea.Cmd {
+	target := transfer.target
+
+	return func() tea.Msg {
+		defer transfer.cancel()
+
+		output, path, e := file.CreateUnique(directory, file.SafeName(target.ID), ".zip")
+		if e != nil {
+			return err.ErrorMsg{Err: fmt.Errorf("error downloading %s: %w", target.Name, e), Source: ErrorSource}
+		}
+
+		progress := func(downloaded, total int64) {
+			transfer.downloaded.Store(downloaded)
+			transfer.total.Store(total)
+		}
+
+		if target.ArtifactType == "" {
+			e = api.DownloadContentPackage(transfer.ctx, target.ID, output, progress)
+		} else {
+			e = api.DownloadArtifact(transfer.ctx, target.ArtifactType, target.ID, output, progress)
+		}
+
+		if closeErr := output.Close(); e == nil {
+			e = closeErr
+		}
+
+		if e != nil {
+			_ = os.Remove(path)
+
+			if transfer.ctx.Err() != nil {
+				return CancelledMsg{Target: target, transfer: transfer}
+			}
+
+			return err.ErrorMsg{Err: fmt.Errorf("error downloading %s: %w", target.Name, e), Source: ErrorSource}
+		}
+
+		return DownloadedMsg{
+			Target:   target,
+			Path:     path,
+			Size:     transfer.downloaded.Load(),
+			transfer: transfer,
+		}
+	}
+}
+
+func progressCmd(transfer *transfer) tea.Cmd {
+	return tea.Tick(progressInterval, func(time.Time) tea.Msg {
+		return ProgressMsg{transfer: transfer}
+	})
+}
+
+func closeCmd() tea.Msg {
+	return CloseMsg{}
+}
@@ -0,0 +1,348 @@
+package entry
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/config"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/pane"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/tools/file"
+)
+
+// Model lists entries of the tenant keystore, soonest expiring first. Entries that have expired
+// or expire within the configured number of days are highlighted, and public certificates
+// of entries can be exported as PEM files.
+type Model struct {
+	common  common.Common
+	entries list.Model
+	loader  pane.Loader
+	width   int
+}
+
+const (
+	// ErrorSource identifies errors of commands that load keystore entries.
+	ErrorSource = "keystore"
+	// ExportErrorSource identifies errors of commands that export certificates.
+	ExportErrorSource = "certificate"
+)
+
+type (
+	EntriesMsg struct {
+		Entries []api.KeystoreEntry
+		ctx     context.Context
+	}
+	// ExportedMsg reports the file the certificate of the keystore entry has been exported to.
+	ExportedMsg struct {
+		Alias string
+		Path  string
+	}
+)
+
+func New() *Model {
+	common := common.New()
+	styles := common.Styles.KeystorePane
+
+	entries := list.New(make([]list.Item, 0), NewKeystoreItemDelegate(), 0, 0)
+	entries.DisableQuitKeybindings()
+	entries.SetShowHelp(false)
+	entries.SetShowTitle(false)
+	entries.SetShowPagination(false)
+	entries.SetShowStatusBar(false)
+	entries.SetFilteringEnabled(false)
+	entries.SetStatusBarItemName("entry", "entries")
+	entries.Styles.NoItems = styles.Dataset.NoItems
+
+	return &Model{
+		common:  common,
+		entries: entries,
+		loader:  pane.NewLoader(ErrorSource, styles.Dataset.Loading),
+	}
+}
+
+func (*Model) Init() tea.Cmd {
+	return nil
+}
+
+func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var (
+		cmd  tea.Cmd
+		cmds = make([]tea.Cmd, 0)
+	)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, model.common.KeyMap.Up), key.Matches(msg, model.common.KeyMap.Down):
+			model.entries, cmd = model.entries.Update(msg)
+			cmds = append(cmds, cmd)
+
+		case key.Matches(msg, model.common.KeyMap.Save):
+			cmds = append(cmds, model.exportCmd())
+
+		case key.Matches(msg, model.common.KeyMap.Refresh):
+			cmds = append(cmds, model.EntriesCmd())
+		}
+
+	case spinner.TickMsg, err.ErrorMsg, err.RetryMsg:
+		cmds = append(cmds, model.loader.Update(msg))
+
+	case EntriesMsg:
+		if !model.loader.Done(msg.ctx) {
+			break
+		}
+
+		items := make([]list.Item, 0, len(msg.Entries))
+		for _, entry := range sorted(msg.Entries) {
+			items = append(items, Item(entry))
+		}
+
+		cmds = append(cmds,
+			statusbar.StatusMessageCmd(fmt.Sprintf("Loaded %d keystore entries in %d ms",
+				len(msg.Entries), model.loader.Elapsed().Milliseconds())),
+			statusbar.RefreshedCmd("Keystore", time.Now()),
+			model.entries.SetItems(items),
+		)
+
+	case ExportedMsg:
+		cmds = append(cmds, statusbar.StatusMessageCmd(fmt.Sprintf("Exported certificate %s to %s", msg.Alias, msg.Path)))
+	}
+
+	return model, tea.Batch(cmds...)
+}
+
+// SetSize resizes the pane, including its border.
+func (model *Model) SetSize(width, height int) {
+	styles := model.common.Styles.KeystorePane
+
+	model.width = max(1, width-styles.Area.GetHorizontalFrameSize())
+	model.common.Styles.KeystorePane.Area = styles.Area.
+		Width(model.width).
+		Height(max(0, height-styles.Area.GetVerticalFrameSize()))
+	model.common.Styles.KeystorePane.Title = styles.Title.Width(model.width)
+
+	// The title, the table header, the details of the selected entry and the footer surround the list.
+	const reservedLines = 8
+
+	pane.FitList(&model.entries, model.width, height-styles.Area.GetVerticalFrameSize(), reservedLines)
+}
+
+func (model *Model) View() string {
+	styles := model.common.Styles.KeystorePane
+
+	return styles.Area.Render(lipgloss.JoinVertical(lipgloss.Left,
+		styles.Title.Render("Keystore"),
+		styles.Header.Width(model.width).Render(
+			row(model.width, styles.Header,
+				"Alias", "Type", "Key", "Valid from", "Valid until", "Subject", "Issuer"),
+		),
+		lipgloss.NewStyle().Height(model.entries.Height()).Render(model.loader.View(model.entries, "Loading keystore…")),
+		model.detailView(),
+		styles.Footer.Width(model.width).Render(ansi.Truncate(model.footerView(), model.width, "…")),
+	))
+}
+
+// detailView shows the subject, the issuer and the validity of the selected entry in full.
+func (model *Model) detailView() string {
+	styles := model.common.Styles.KeystorePane
+	lines := make([]string, 3)
+
+	if entry := model.SelectedEntry(); entry != nil {
+		status := styles.Detail.Render(daysLeft(*entry, time.Now()))
+		if style := model.expiryStyle(*entry); style != nil {
+			status = style.Render(daysLeft(*entry, time.Now()))
+		}
+
+		validity := []string{status}
+
+		for _, value := range []string{entry.SignatureAlgorithm, entry.Owner} {
+			if value != "" {
+				validity = append(validity, styles.Detail.Render(value))
+			}
+		}
+
+		lines = []string{
+			styles.Detail.Render("Subject  " + entry.SubjectDN),
+			styles.Detail.Render("Issuer   " + entry.IssuerDN),
+			styles.Detail.Render("Validity ") + strings.Join(validity, styles.Detail.Render(" · ")),
+		}
+	}
+
+	for idx, line := range lines {
+		lines[idx] = ansi.Truncate(line, model.width, "…")
+	}
+
+	return styles.Detail.Width(model.width).
+		Border(lipgloss.NormalBorder(), true, false, false, false).
+		BorderForeground(styles.Area.GetBorderTopForeground()).
+		Render(strings.Join(lines, "\n"))
+}
+
+func (model *Model) expiryStyle(entry api.KeystoreEntry) *lipgloss.Style {
+	styles := model.common.Styles.KeystorePane.Dataset.Item
+
+	switch expiry(entry, time.Now()) {
+	case expiryExpired:
+		return &styles.Expired
+	case expiryExpiring:
+		return &styles.Expiring
+	default:
+		return nil
+	}
+}
+
+func (model *Model) footerView() string {
+	keys := model.common.KeyMap
+	count := len(model.entries.Items())
+
+	switch {
+	case model.loader.Loading() && count > 0:
+		return model.loader.Spinner() + " Loading keystore…"
+	case count > 0:
+		expiring, expired := 0, 0
+
+		for _, item := range model.entries.Items() {
+			switch expiry(api.KeystoreEntry(item.(Item)), time.Now()) {
+			case expiryExpiring:
+				expiring++
+			case expiryExpired:
+				expired++
+			}
+		}
+
+		return fmt.Sprintf("%d entries · %d expiring within %s · %d expired · %s export certificate · %s back",
+			count, expiring, plural(config.UIKeystoreExpiryWarningDays(), "day"), expired,
+			keys.Save.Help().Key, keys.Close.Help().Key)
+	default:
+		return ""
+	}
+}
+
+// Open lists entries of the keystore.
+func (model *Model) Open() tea.Cmd {
+	return model.EntriesCmd()
+}
+
+// EntriesCmd loads entries of the keystore, cancelling the load that is still in progress, if any.
+func (model *Model) EntriesCmd() tea.Cmd {
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Fetching keystore…"),
+		model.loader.Load(func(ctx context.Context) (tea.Msg, error) {
+			entries, e := api.KeystoreEntries(ctx)
+
+			return EntriesMsg{
+				Entries: entries,
+				ctx:     ctx,
+			}, e
+		}),
+	)
+}
+
+// CancelCmds cancels the load that is still in progress, if any.
+func (model *Model) CancelCmds() {
+	model.loader.Cancel()
+}
+
+// exportCmd saves the public certificate of the selected entry as a PEM file in the download directory.
+// The file is named after the alias and is never overwritten.
+func (model *Model) exportCmd() tea.Cmd {
+	entry := model.SelectedEntry()
+	if entry == nil {
+		return nil
+	}
+
+	if !entry.HasCertificate() {
+		return statusbar.StatusMessageCmd(fmt.Sprintf("%s is a %s without a certificate",
+			entry.Alias, strings.ToLower(typeName(entry.Type))))
+	}
+
+	var cmd tea.Cmd
+
+	cmd = func() tea.Msg {
+		content, e := api.CertificatePEM(context.Background(), entry.Hexalias)
+		if e != nil {
+			return err.ErrorMsg{Err: fmt.Errorf("error exporting certificate %s: %w", entry.Alias, e),
+				Source: ExportErrorSource, Retry: cmd}
+		}
+
+		directory := config.DownloadDirectory()
+		if e := os.MkdirAll(directory, 0o750); e != nil {
+			return err.ErrorMsg{Err: fmt.Errorf("error exporting certificate %s: %w", entry.Alias, e),
+				Source: ExportErrorSource}
+		}
+
+		output, path, e := file.CreateUnique(directory, file.SafeName(entry.Alias), ".pem")
+		if e != nil {
+			return err.ErrorMsg{Err: fmt.Errorf("error exporting certificate %s: %w", entry.Alias, e),
+				Source: ExportErrorSource}
+		}
+
+		_, e = output.Write(content)
+		if closeErr := output.Close(); e == nil {
+			e = closeErr
+		}
+
+		if e != nil {
+			_ = os.Remove(path)
+
+			return err.ErrorMsg{Err: fmt.Errorf("error exporting certificate %s: %w", entry.Alias, e),
+				Source: ExportErrorSource}
+		}
+
+		return ExportedMsg{Alias: entry.Alias, Path: path}
+	}
+
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Exporting certificate "+entry.Alias+"…"),
+		cmd,
+	)
+}
+
+// SelectedEntry returns the selected keystore entry, if any.
+func (model *Model) SelectedEntry() *api.KeystoreEntry {
+	selectedItem := model.entries.SelectedItem()
+	if selectedItem == nil {
+		return nil
+	}
+
+	entry := api.KeystoreEntry(selectedItem.(Item))
+
+	return &entry
+}
+
+// sorted orders entries by expiry, soonest first. Entries that never expire come last, by alias.
+func sorted(entries []api.KeystoreEntry) []api.KeystoreEntry {
+	entries = slices.Clone(entries)
+
+	slices.SortStableFunc(entries, func(a, b api.KeystoreEntry) int {
+		if a.ValidNotAfter.IsZero() != b.ValidNotAfter.IsZero() {
+			if a.ValidNotAfter.IsZero() {
+				return 1
+			}
+
+			return -1
+		}
+
+		if c := a.ValidNotAfter.Compare(b.ValidNotAfter.Time); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.Alias, b.Alias)
+	})
+
+	return entries
+}
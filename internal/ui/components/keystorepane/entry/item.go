@@ -0,0 +1,186 @@
+package entry
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/config"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+)
+
+type Item api.KeystoreEntry
+
+type ItemDelegate struct {
+	common common.Common
+}
+
+// Expiry of a keystore entry relative to the configured warning threshold.
+const (
+	expiryValid = iota
+	expiryExpiring
+	expiryExpired
+)
+
+const (
+	typeColumnWidth = 11
+	keyColumnWidth  = 10
+	dateColumnWidth = 11
+	columnGap       = 1
+	hoursPerDay     = 24
+)
+
+var typeNames = map[string]string{
+	api.KeystoreEntryTypeKeyPair:     "Key pair",
+	api.KeystoreEntryTypeCertificate: "Certificate",
+	api.KeystoreEntryTypeSecretKey:   "Secret key",
+}
+
+func (item Item) FilterValue() string {
+	return item.Alias
+}
+
+func NewKeystoreItemDelegate() ItemDelegate {
+	return ItemDelegate{
+		common: common.New(),
+	}
+}
+
+func (ItemDelegate) Height() int {
+	return 1
+}
+
+func (ItemDelegate) Spacing() int {
+	return 0
+}
+
+func (ItemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd {
+	return nil
+}
+
+func (itemDelegate ItemDelegate) Render(writer io.Writer, model list.Model, index int, listItem list.Item) {
+	item := listItem.(Item)
+	styles := itemDelegate.common.Styles.KeystorePane.Dataset
+
+	var style lipgloss.Style
+
+	switch {
+	case index == model.Index():
+		style = styles.Item.Selected
+	case expiry(api.KeystoreEntry(item), time.Now()) == expiryExpired:
+		style = styles.Item.Expired
+	case expiry(api.KeystoreEntry(item), time.Now()) == expiryExpiring:
+		style = styles.Item.Expiring
+	default:
+		style = styles.Item.Normal
+	}
+
+	cell := lipgloss.NewStyle().
+		Background(style.GetBackground()).
+		Foreground(style.GetForeground())
+
+	fmt.Fprint(writer, style.Width(model.Width()).MaxWidth(model.Width()).Render(
+		row(model.Width(), cell,
+			item.Alias, typeName(item.Type), keyName(api.KeystoreEntry(item)),
+			date(item.ValidNotBefore), date(item.ValidNotAfter), item.SubjectDN, item.IssuerDN),
+	))
+}
+
+// row lays out the alias, type, key, validity, subject and issuer columns of the keystore table within the width.
+func row(width int, style lipgloss.Style, alias, entryType, key, validFrom, validUntil, subject, issuer string) string {
+	const columns = 7
+
+	remaining := max(0, width-typeColumnWidth-keyColumnWidth-2*dateColumnWidth-(columns-1)*columnGap)
+	aliasWidth := remaining * 3 / 10
+	subjectWidth := (remaining - aliasWidth) / 2
+	issuerWidth := remaining - aliasWidth - subjectWidth
+	gap := style.Render(strings.Repeat(" ", columnGap))
+
+	cell := func(value string, width int) string {
+		return style.Width(width).Render(ansi.Truncate(value, width, "…"))
+	}
+
+	return cell(alias, aliasWidth) + gap +
+		cell(entryType, typeColumnWidth) + gap +
+		cell(key, keyColumnWidth) + gap +
+		cell(validFrom, dateColumnWidth) + gap +
+		cell(validUntil, dateColumnWidth) + gap +
+		cell(subject, subjectWidth) + gap +
+		cell(issuer, issuerWidth)
+}
+
+// expiry tells whether the entry has expired or expires within the configured number of days.
+// Entries without a validity period, e.g. secret keys, never expire.
+func expiry(entry api.KeystoreEntry, now time.Time) int {
+	switch {
+	case entry.ValidNotAfter.IsZero():
+		return expiryValid
+	case entry.ValidNotAfter.Before(now):
+		return expiryExpired
+	case entry.ValidNotAfter.Before(now.AddDate(0, 0, config.UIKeystoreExpiryWarningDays())):
+		return expiryExpiring
+	default:
+		return expiryValid
+	}
+}
+
+// daysLeft describes how long the entry remains valid, or how long ago it expired.
+func daysLeft(entry api.KeystoreEntry, now time.Time) string {
+	if entry.ValidNotAfter.IsZero() {
+		return "No expiry"
+	}
+
+	days := int(math.Round(entry.ValidNotAfter.Sub(now).Hours() / hoursPerDay))
+
+	switch {
+	case entry.ValidNotAfter.Before(now) && days == 0:
+		return "Expired today"
+	case entry.ValidNotAfter.Before(now):
+		return "Expired " + plural(-days, "day") + " ago"
+	case days == 0:
+		return "Expires today"
+	default:
+		return "Expires in " + plural(days, "day")
+	}
+}
+
+func typeName(entryType string) string {
+	if name, ok := typeNames[entryType]; ok {
+		return name
+	}
+
+	return entryType
+}
+
+// keyName combines the key algorithm and the key size, e.g. RSA 2048.
+func keyName(entry api.KeystoreEntry) string {
+	if entry.KeySize == 0 {
+		return entry.KeyType
+	}
+
+	return strings.TrimSpace(entry.KeyType + " " + strconv.Itoa(entry.KeySize))
+}
+
+func date(dateTime api.DateTime) string {
+	if dateTime.IsZero() {
+		return ""
+	}
+
+	return dateTime.UTC().Format(time.DateOnly)
+}
+
+func plural(count int, noun string) string {
+	if count == 1 {
+		return "1 " + noun
+	}
+
+	return strconv.Itoa(count) + " " + noun + "s"
+}
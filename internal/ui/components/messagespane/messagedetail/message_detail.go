@@ -0,0 +1,429 @@
+package messagedetail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/pane"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/tools/file"
+)
+
+// Model displays details of a single message processing log: its error text, custom header properties,
+// attachments and steps of its runs. Attachments can be viewed and saved to the working directory.
+type Model struct {
+	common          common.Common
+	body            viewport.Model
+	loader          pane.Loader
+	log             api.MessageProcessingLog
+	detail          *DetailMsg
+	selected        int
+	attachmentsLine int
+	attachment      *AttachmentMsg
+	width           int
+}
+
+// ErrorSource identifies errors of commands that load details of the message.
+const ErrorSource = "message"
+
+// SaveErrorSource identifies errors of commands that save attachments.
+const SaveErrorSource = "attachment"
+
+// Attachments larger than this are not displayed, but can still be saved.
+const maxViewableAttachmentSize = 1 << 20
+
+type DetailMsg struct {
+	MessageGUID            string
+	ErrorInformation       string
+	CustomHeaderProperties []api.MessageProcessingLogCustomHeaderProperty
+	Attachments            []api.MessageProcessingLogAttachment
+	Runs                   []api.MessageProcessingLogRun
+	RunSteps               map[string][]api.MessageProcessingLogRunStep
+	ctx                    context.Context
+}
+
+type AttachmentMsg struct {
+	Attachment api.MessageProcessingLogAttachment
+	Content    []byte
+	ctx        context.Context
+}
+
+func New() *Model {
+	common := common.New()
+
+	return &Model{
+		common: common,
+		body:   viewport.New(0, 0),
+		loader: pane.NewLoader(ErrorSource, common.Styles.MessageDetail.Loading),
+	}
+}
+
+func (*Model) Init() tea.Cmd {
+	return nil
+}
+
+func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var (
+		cmd  tea.Cmd
+		cmds = make([]tea.Cmd, 0)
+	)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, model.common.KeyMap.Tab):
+			if model.attachment == nil && model.detail != nil && len(model.detail.Attachments) > 0 {
+				model.selected = (model.selected + 1) % len(model.detail.Attachments)
+				model.render()
+				model.scrollToSelectedAttachment()
+			}
+
+		case key.Matches(msg, model.common.KeyMap.Enter):
+			if attachment := model.SelectedAttachment(); attachment != nil && model.attachment == nil {
+				cmds = append(cmds, model.attachmentCmd(*attachment))
+			}
+
+		case key.Matches(msg, model.common.KeyMap.Save):
+			if attachment := model.SelectedAttachment(); attachment != nil {
+				cmds = append(cmds, model.saveAttachmentCmd(*attachment))
+			}
+
+		case key.Matches(msg, model.common.KeyMap.Refresh):
+			if model.attachment == nil {
+				cmds = append(cmds, model.DetailCmd())
+			}
+
+		default:
+			model.body, cmd = model.body.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+
+	case spinner.TickMsg, err.ErrorMsg, err.RetryMsg:
+		cmds = append(cmds, model.loader.Update(msg))
+
+	case DetailMsg:
+		// The details belong to a message that is no longer displayed.
+		if !model.loader.Done(msg.ctx) {
+			break
+		}
+
+		model.detail = &msg
+		model.selected = min(model.selected, max(0, len(msg.Attachments)-1))
+		model.render()
+
+		cmds = append(cmds,
+			statusbar.StatusMessageCmd(fmt.Sprintf("Loaded message details in %d ms",
+				model.loader.Elapsed().Milliseconds())),
+			statusbar.RefreshedCmd("Message", time.Now()),
+		)
+
+	case AttachmentMsg:
+		if !model.loader.Done(msg.ctx) {
+			break
+		}
+
+		model.attachment = &msg
+		model.body.GotoTop()
+		model.render()
+	}
+
+	return model, tea.Batch(cmds...)
+}
+
+// SetSize resizes the view, including its border.
+func (model *Model) SetSize(width, height int) {
+	styles := model.common.Styles.MessageDetail
+
+	model.width = max(1, width-styles.Area.GetHorizontalFrameSize())
+	model.common.Styles.MessageDetail.Area = styles.Area.
+		Width(model.width).
+		Height(max(0, height-styles.Area.GetVerticalFrameSize()))
+	model.common.Styles.MessageDetail.Title = styles.Title.Width(model.width)
+
+	// The title and the footer surround the body.
+	const reservedLines = 3
+
+	model.body.Width = model.width
+	model.body.Height = max(1, height-styles.Area.GetVerticalFrameSize()-reservedLines)
+	model.render()
+}
+
+func (model *Model) View() string {
+	styles := model.common.Styles.MessageDetail
+
+	var (
+		title   = "Message " + model.log.MessageGUID
+		content = model.body.View()
+	)
+
+	if model.attachment != nil {
+		title = fmt.Sprintf("Attachment %s of message %s", model.attachment.Attachment.Name, model.log.MessageGUID)
+	}
+
+	if e := model.loader.Err(); e != nil {
+		content = lipgloss.NewStyle().Height(model.body.Height).
+			Render(err.Render(model.common.Styles, e, model.width))
+	}
+
+	return styles.Area.Render(lipgloss.JoinVertical(lipgloss.Left,
+		styles.Title.Render(title),
+		content,
+		styles.Footer.Width(model.width).Render(model.footerView()),
+	))
+}
+
+func (model *Model) footerView() string {
+	keys := model.common.KeyMap
+
+	switch {
+	case model.loader.Loading():
+		return model.loader.Spinner() + " Loading…"
+	case model.attachment != nil:
+		return fmt.Sprintf("%s save · %s back", keys.Save.Help().Key, keys.Close.Help().Key)
+	case model.detail != nil && len(model.detail.Attachments) > 0:
+		return fmt.Sprintf("%s next attachment · %s view · %s save · %s open in Web UI · %s back",
+			keys.Tab.Help().Key, keys.Enter.Help().Key, keys.Save.Help().Key, keys.Open.Help().Key,
+			keys.Close.Help().Key)
+	default:
+		return fmt.Sprintf("%s open in Web UI · %s back", keys.Open.Help().Key, keys.Close.Help().Key)
+	}
+}
+
+// Open displays details of the message processing log.
+func (model *Model) Open(log api.MessageProcessingLog) tea.Cmd {
+	if log.MessageGUID != model.log.MessageGUID {
+		model.detail = nil
+		model.selected = 0
+	}
+
+	model.log = log
+	model.attachment = nil
+	model.body.GotoTop()
+	model.render()
+
+	return model.DetailCmd()
+}
+
+// MessageLog returns the message processing log whose details are displayed.
+func (model *Model) MessageLog() api.MessageProcessingLog {
+	return model.log
+}
+
+// Back closes the attachment that is viewed. It reports false if no attachment is viewed.
+func (model *Model) Back() bool {
+	if model.attachment == nil {
+		return false
+	}
+
+	model.attachment = nil
+	model.render()
+
+	return true
+}
+
+// DetailCmd loads details of the message, cancelling the load that is still in progress, if any.
+func (model *Model) DetailCmd() tea.Cmd {
+	messageGUID := model.log.MessageGUID
+
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Fetching message details…"),
+		model.loader.Load(func(ctx context.Context) (tea.Msg, error) {
+			detail, e := fetchDetail(ctx, messageGUID)
+			if e != nil {
+				return nil, e
+			}
+
+			return *detail, nil
+		}),
+	)
+}
+
+// CancelCmds cancels the load that is still in progress, if any.
+func (model *Model) CancelCmds() {
+	model.loader.Cancel()
+}
+
+// SelectedAttachment returns the viewed attachment or the attachment that is selected in the list, if any.
+func (model *Model) SelectedAttachment() *api.MessageProcessingLogAttachment {
+	if model.attachment != nil {
+		return &model.attachment.Attachment
+	}
+
+	if model.detail == nil || model.selected >= len(model.detail.Attachments) {
+		return nil
+	}
+
+	return &model.detail.Attachments[model.selected]
+}
+
+// attachmentCmd loads the content of the attachment as part of the load of the message details,
+// so that it is cancelled along with them.
+func (model *Model) attachmentCmd(attachment api.MessageProcessingLogAttachment) tea.Cmd {
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Fetching attachment "+attachment.Name+"…"),
+		model.loader.Continue(func(ctx context.Context) (tea.Msg, error) {
+			content, e := api.MessageProcessingLogAttachmentContent(ctx, attachment.ID)
+			if e != nil {
+				return nil, e
+			}
+
+			return AttachmentMsg{
+				Attachment: attachment,
+				Content:    content,
+				ctx:        ctx,
+			}, nil
+		}),
+	)
+}
+
+func (model *Model) saveAttachmentCmd(attachment api.MessageProcessingLogAttachment) tea.Cmd {
+	var (
+		cmd     tea.Cmd
+		content []byte
+	)
+
+	// The viewed attachment has already been fetched.
+	if model.attachment != nil && model.attachment.Attachment.ID == attachment.ID {
+		content = model.attachment.Content
+	}
+
+	messageGUID := model.log.MessageGUID
+
+	cmd = func() tea.Msg {
+		if content == nil {
+			var e error
+
+			content, e = api.MessageProcessingLogAttachmentContent(context.Background(), attachment.ID)
+			if e != nil {
+				return err.ErrorMsg{Err: e, Source: SaveErrorSource, Retry: cmd}
+			}
+		}
+
+		path, e := saveAttachment(messageGUID, attachment, content)
+		if e != nil {
+			return err.ErrorMsg{Err: e, Source: SaveErrorSource, Retry: cmd}
+		}
+
+		return statusbar.StatusMsg(fmt.Sprintf("Saved attachment %s to %s", attachment.Name, path))
+	}
+
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Saving attachment "+attachment.Name+"…"),
+		cmd,
+	)
+}
+
+// fetchDetail fetches details of the message concurrently and fails if any of them can't be fetched.
+func fetchDetail(ctx context.Context, messageGUID string) (*DetailMsg, error) {
+	var (
+		detail = &DetailMsg{MessageGUID: messageGUID, ctx: ctx}
+		group  sync.WaitGroup
+		errs   = make([]error, 4)
+	)
+
+	group.Add(len(errs))
+
+	go func() {
+		defer group.Done()
+
+		detail.ErrorInformation, errs[0] = api.MessageProcessingLogErrorInformation(ctx, messageGUID)
+	}()
+
+	go func() {
+		defer group.Done()
+
+		detail.CustomHeaderProperties, errs[1] = api.MessageProcessingLogCustomHeaderProperties(ctx, messageGUID)
+	}()
+
+	go func() {
+		defer group.Done()
+
+		detail.Attachments, errs[2] = api.MessageProcessingLogAttachments(ctx, messageGUID)
+	}()
+
+	go func() {
+		defer group.Done()
+
+		detail.Runs, detail.RunSteps, errs[3] = fetchRuns(ctx, messageGUID)
+	}()
+
+	group.Wait()
+
+	if e := errors.Join(errs...); e != nil {
+		return nil, e
+	}
+
+	return detail, nil
+}
+
+func fetchRuns(ctx context.Context, messageGUID string,
+) ([]api.MessageProcessingLogRun, map[string][]api.MessageProcessingLogRunStep, error) {
+	runs, e := api.MessageProcessingLogRuns(ctx, messageGUID)
+	if e != nil {
+		return nil, nil, e
+	}
+
+	steps := make(map[string][]api.MessageProcessingLogRunStep, len(runs))
+
+	for _, run := range runs {
+		runSteps, e := api.MessageProcessingLogRunSteps(ctx, run.ID)
+		if e != nil {
+			return nil, nil, e
+		}
+
+		steps[run.ID] = runSteps
+	}
+
+	return runs, steps, nil
+}
+
+// saveAttachment writes the content to a new file in the working directory. The file is named after
+// the message and the attachment, and is never overwritten.
+func saveAttachment(messageGUID string, attachment api.MessageProcessingLogAttachment, content []byte,
+) (string, error) {
+	name := attachment.Name
+	if name == "" {
+		name = attachment.ID
+	}
+
+	name = file.SafeName(messageGUID + "_" + name)
+
+	extension := filepath.Ext(name)
+	if extension == "" {
+		if extensions, e := mime.ExtensionsByType(attachment.ContentType); e == nil && len(extensions) > 0 {
+			extension = extensions[0]
+		}
+	} else {
+		name = strings.TrimSuffix(name, extension)
+	}
+
+	output, path, e := file.CreateUnique(".", name, extension)
+	if e != nil {
+		return "", fmt.Errorf("error saving attachment %s: %w", attachment.Name, e)
+	}
+
+	_, e = output.Write(content)
+	if closeErr := output.Close(); e == nil {
+		e = closeErr
+	}
+
+	if e != nil {
+		return "", fmt.Errorf("error saving attachment %s: %w", attachment.Name, e)
+	}
+
+	return path, nil
+}
@@ -0,0 +1,255 @@
+package messagedetail
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/format"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/messagespane/messagelog"
+)
+
+const (
+	labelWidth     = 22
+	columnGap      = 1
+	stepTimeFormat = "15:04:05.000"
+	tabWidth       = 4
+)
+
+// render lays out the body for the current width.
+func (model *Model) render() {
+	if model.attachment != nil {
+		model.body.SetContent(model.attachmentView())
+
+		return
+	}
+
+	lines := make([]string, 0)
+	lines = append(lines, model.summaryView()...)
+
+	if model.detail == nil {
+		model.body.SetContent(strings.Join(lines, "\n"))
+
+		return
+	}
+
+	lines = append(lines, model.errorInformationView()...)
+	lines = append(lines, model.customHeaderPropertiesView()...)
+
+	attachments := model.attachmentsView()
+	model.attachmentsLine = lipgloss.Height(strings.Join(lines, "\n")) + lipgloss.Height(attachments[0])
+
+	lines = append(lines, attachments...)
+	lines = append(lines, model.runStepsView()...)
+
+	model.body.SetContent(strings.Join(lines, "\n"))
+}
+
+// scrollToSelectedAttachment scrolls the body if the selected attachment is out of view.
+func (model *Model) scrollToSelectedAttachment() {
+	selectedLine := model.attachmentsLine + model.selected
+	if selectedLine < model.body.YOffset || selectedLine >= model.body.YOffset+model.body.Height {
+		model.body.SetYOffset(selectedLine - model.body.Height/2)
+	}
+}
+
+func (model *Model) summaryView() []string {
+	log := model.log
+
+	status := log.Status
+	if log.CustomStatus != "" && log.CustomStatus != log.Status {
+		status += " (" + log.CustomStatus + ")"
+	}
+
+	applicationMessage := log.ApplicationMessageID
+	if log.ApplicationMessageType != "" {
+		applicationMessage += " (" + log.ApplicationMessageType + ")"
+	}
+
+	end := ""
+	if !log.LogEnd.IsZero() {
+		end = log.LogEnd.Format(time.DateTime)
+	}
+
+	statusStyle := messagelog.StatusStyle(model.common, log.Status).Inherit(model.common.Styles.MessageDetail.Value)
+
+	return []string{
+		model.styledField("Status", status, statusStyle),
+		model.field("Message ID", log.MessageGUID),
+		model.field("Correlation ID", log.CorrelationID),
+		model.field("Application message", applicationMessage),
+		model.field("Integration flow", log.IntegrationArtifact.Name+" ("+log.IntegrationArtifact.ID+")"),
+		model.field("Package", log.IntegrationArtifact.PackageName),
+		model.field("Sender", log.Sender),
+		model.field("Receiver", log.Receiver),
+		model.field("Start (UTC)", log.LogStart.Format(time.DateTime)),
+		model.field("End (UTC)", end),
+		model.field("Duration", messagelog.Duration(log)),
+		model.field("Log level", log.LogLevel),
+	}
+}
+
+func (model *Model) errorInformationView() []string {
+	styles := model.common.Styles.MessageDetail
+	lines := []string{styles.Section.Render("Error")}
+
+	if model.detail.ErrorInformation == "" {
+		return append(lines, styles.Empty.Render("No error"))
+	}
+
+	text := styles.Error.Width(model.width).Render(printable(model.detail.ErrorInformation))
+
+	return append(lines, strings.Split(text, "\n")...)
+}
+
+func (model *Model) customHeaderPropertiesView() []string {
+	styles := model.common.Styles.MessageDetail
+	lines := []string{styles.Section.Render("Custom header properties")}
+
+	if len(model.detail.CustomHeaderProperties) == 0 {
+		return append(lines, styles.Empty.Render("No custom header properties"))
+	}
+
+	for _, property := range model.detail.CustomHeaderProperties {
+		lines = append(lines, model.field(property.Name, property.Value))
+	}
+
+	return lines
+}
+
+func (model *Model) attachmentsView() []string {
+	styles := model.common.Styles.MessageDetail
+	lines := []string{styles.Section.Render("Attachments")}
+
+	if len(model.detail.Attachments) == 0 {
+		return append(lines, styles.Empty.Render("No attachments"))
+	}
+
+	for idx, attachment := range model.detail.Attachments {
+		style := styles.Attachment.Normal
+		if idx == model.selected {
+			style = styles.Attachment.Selected
+		}
+
+		lines = append(lines, style.Width(model.width).Render(columns(model.width, []int{0, 24, 10, 19},
+			attachment.Name,
+			attachment.ContentType,
+			format.Size(attachment.PayloadSize),
+			attachment.TimeStamp.Format(time.DateTime),
+		)))
+	}
+
+	return lines
+}
+
+func (model *Model) runStepsView() []string {
+	styles := model.common.Styles.MessageDetail
+	lines := []string{styles.Section.Render("Run steps")}
+
+	if len(model.detail.Runs) == 0 {
+		return append(lines, styles.Empty.Render("No runs"))
+	}
+
+	widths := []int{len(stepTimeFormat), 10, 28, 0}
+
+	for idx, run := range model.detail.Runs {
+		lines = append(lines,
+			styles.Label.Render(fmt.Sprintf("Run %d of %d · %s · %s",
+				idx+1, len(model.detail.Runs), run.RunStart.Format(time.DateTime), run.OverallState)),
+			styles.Header.Render(columns(model.width, widths, "Start", "Status", "Activity", "Step")),
+		)
+
+		for _, step := range model.detail.RunSteps[run.ID] {
+			lines = append(lines, columns(model.width, widths,
+				step.StepStart.Format(stepTimeFormat),
+				step.Status,
+				step.Activity,
+				step.ModelStepID,
+			))
+
+			if step.Error != "" {
+				indent := widths[0] + columnGap
+				text := styles.Error.Width(max(1, model.width-indent)).Render(printable(step.Error))
+
+				for _, line := range strings.Split(text, "\n") {
+					lines = append(lines, strings.Repeat(" ", indent)+line)
+				}
+			}
+		}
+	}
+
+	return lines
+}
+
+func (model *Model) attachmentView() string {
+	styles := model.common.Styles.MessageDetail
+	content := model.attachment.Content
+
+	switch {
+	case len(content) == 0:
+		return styles.Empty.Render("The attachment is empty")
+	case len(content) > maxViewableAttachmentSize:
+		return styles.Empty.Render(fmt.Sprintf("The attachment is too large to display (%s), save it with %s",
+			format.Size(int64(len(content))), model.common.KeyMap.Save.Help().Key))
+	case !utf8.Valid(content):
+		return styles.Empty.Render(fmt.Sprintf("The attachment is binary (%s), save it with %s",
+			format.Size(int64(len(content))), model.common.KeyMap.Save.Help().Key))
+	default:
+		return styles.Value.Width(model.width).Render(printable(string(content)))
+	}
+}
+
+// field renders a labelled value of the message.
+func (model *Model) field(label, value string) string {
+	return model.styledField(label, value, model.common.Styles.MessageDetail.Value)
+}
+
+func (model *Model) styledField(label, value string, style lipgloss.Style) string {
+	return model.common.Styles.MessageDetail.Label.Width(labelWidth).
+		Render(ansi.Truncate(printable(label), labelWidth-columnGap, "…")) +
+		style.Render(ansi.Truncate(printable(value), max(0, model.width-labelWidth), "…"))
+}
+
+// columns lays out values in columns of the given widths. A zero width column takes up the remaining width,
+// which is shared between all such columns.
+func columns(width int, widths []int, values ...string) string {
+	fixed, flexible := 0, 0
+
+	for _, columnWidth := range widths {
+		if columnWidth == 0 {
+			flexible++
+		}
+
+		fixed += columnWidth + columnGap
+	}
+
+	cells := make([]string, 0, len(values))
+
+	for idx, value := range values {
+		columnWidth := widths[idx]
+		if columnWidth == 0 {
+			columnWidth = max(0, width-fixed+columnGap) / flexible
+		}
+
+		cells = append(cells, lipgloss.NewStyle().Width(columnWidth).
+			Render(ansi.Truncate(printable(value), columnWidth, "…")))
+	}
+
+	return strings.Join(cells, strings.Repeat(" ", columnGap))
+}
+
+// printable replaces tabs and control characters that would break the layout.
+func printable(text string) string {
+	text = strings.ReplaceAll(strings.ReplaceAll(text, "\r\n", "\n"), "\t", strings.Repeat(" ", tabWidth))
+
+	return strings.Map(func(r rune) rune {
+		if r < ' ' && r != '\n' {
+			return -1
+		}
+
+		return r
+	}, text)
+}
@@ -0,0 +1,183 @@
+package messagelog
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/filter"
+)
+
+type Item api.MessageProcessingLog
+
+type ItemDelegate struct {
+	common          common.Common
+	integrationFlow bool
+}
+
+const (
+	statusColumnWidth   = 10
+	timeColumnWidth     = 19
+	durationColumnWidth = 9
+	maxCorrelationWidth = 36
+	columnGap           = 1
+)
+
+func (item Item) FilterValue() string {
+	return filter.Value(item.CorrelationID, item.ApplicationMessageID, item.MessageGUID, item.Sender, item.Receiver)
+}
+
+// NewMessageLogItemDelegate creates the delegate that renders a message per row. The last column holds
+// the integration flow of the message if integrationFlow is set, or its sender and receiver otherwise.
+func NewMessageLogItemDelegate(integrationFlow bool) ItemDelegate {
+	return ItemDelegate{
+		common:          common.New(),
+		integrationFlow: integrationFlow,
+	}
+}
+
+func (ItemDelegate) Height() int {
+	return 1
+}
+
+func (ItemDelegate) Spacing() int {
+	return 0
+}
+
+func (ItemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd {
+	return nil
+}
+
+func (itemDelegate ItemDelegate) Render(writer io.Writer, model list.Model, index int, listItem list.Item) {
+	item := listItem.(Item)
+	styles := itemDelegate.common.Styles.MessagesPane.Dataset
+
+	var style lipgloss.Style
+	if index == model.Index() {
+		style = styles.Item.Selected
+	} else {
+		style = styles.Item.Normal
+	}
+
+	cell := lipgloss.NewStyle().
+		Background(style.GetBackground()).
+		Foreground(style.GetForeground())
+
+	status := cell
+	if index != model.Index() {
+		status = StatusStyle(itemDelegate.common, item.Status).Inherit(cell)
+	}
+
+	end := ""
+	if !item.LogEnd.IsZero() {
+		end = item.LogEnd.Format(time.DateTime)
+	}
+
+	lastColumn := senderReceiver(item.Sender, item.Receiver)
+	if itemDelegate.integrationFlow {
+		lastColumn = item.IntegrationArtifact.Name
+		if lastColumn == "" {
+			lastColumn = item.IntegrationFlowName
+		}
+	}
+
+	fmt.Fprint(writer, style.Width(model.Width()).MaxWidth(model.Width()).Render(
+		row(model.Width(), cell, status,
+			item.Status,
+			item.LogStart.Format(time.DateTime),
+			end,
+			Duration(api.MessageProcessingLog(item)),
+			item.CorrelationID,
+			lastColumn,
+		),
+	))
+}
+
+// row lays out columns of the message processing logs table within the width.
+// The status column is rendered with its own style.
+func row(width int, style, statusStyle lipgloss.Style, columns ...string) string {
+	widths := columnWidths(width)
+	cells := make([]string, 0, len(columns))
+
+	for idx, column := range columns {
+		cellStyle := style
+		if idx == 0 {
+			cellStyle = statusStyle
+		}
+
+		cells = append(cells, cellStyle.Width(widths[idx]).MaxWidth(widths[idx]).
+			Render(ansi.Truncate(column, widths[idx], "…")))
+	}
+
+	return strings.Join(cells, style.Render(strings.Repeat(" ", columnGap)))
+}
+
+// columnWidths distributes the width between the status, start, end, duration, correlation ID and
+// sender/receiver columns. The correlation ID and sender/receiver columns share the remaining width.
+func columnWidths(width int) []int {
+	const columns = 6
+
+	fixed := statusColumnWidth + 2*timeColumnWidth + durationColumnWidth + (columns-1)*columnGap
+	remaining := max(0, width-fixed)
+	correlationWidth := min(maxCorrelationWidth, remaining/2)
+
+	return []int{
+		statusColumnWidth,
+		timeColumnWidth,
+		timeColumnWidth,
+		durationColumnWidth,
+		correlationWidth,
+		max(0, remaining-correlationWidth),
+	}
+}
+
+// StatusStyle returns the style of the message status.
+func StatusStyle(common common.Common, status string) lipgloss.Style {
+	styles := common.Styles.MessagesPane.Dataset.Status
+
+	switch status {
+	case api.MessageStatusCompleted:
+		return styles.Completed
+	case api.MessageStatusFailed:
+		return styles.Failed
+	case api.MessageStatusRetry, api.MessageStatusEscalated:
+		return styles.Retry
+	case api.MessageStatusProcessing:
+		return styles.Processing
+	default:
+		return styles.Other
+	}
+}
+
+func senderReceiver(sender, receiver string) string {
+	if sender == "" && receiver == "" {
+		return ""
+	}
+
+	return sender + " → " + receiver
+}
+
+// Duration formats the processing time of the message, which is unknown until processing ends.
+func Duration(log api.MessageProcessingLog) string {
+	if log.LogStart.IsZero() || log.LogEnd.IsZero() {
+		return "–"
+	}
+
+	duration := log.LogEnd.Sub(log.LogStart.Time)
+
+	switch {
+	case duration < time.Second:
+		return fmt.Sprintf("%d ms", duration.Milliseconds())
+	case duration < time.Minute:
+		return fmt.Sprintf("%.1f s", duration.Seconds())
+	default:
+		return duration.Round(time.Second).String()
+	}
+}
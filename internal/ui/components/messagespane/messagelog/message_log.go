@@ -0,0 +1,451 @@
+package messagelog
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/pane"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
+)
+
+// Model lists message processing logs of an integration flow or tenant-wide search results, latest first.
+// Older logs are loaded page by page when navigating past the last loaded log.
+type Model struct {
+	common       common.Common
+	logs         list.Model
+	loader       pane.Loader
+	artifactID   string
+	artifactName string
+	search       bool
+	criteria     api.MessageFilter
+	filter       api.MessageFilter
+	timeWindow   int
+	status       int
+	more         bool
+	width        int
+}
+
+type timeWindow struct {
+	label    string
+	duration time.Duration
+}
+
+const (
+	// ErrorSource identifies errors of commands issued by the message processing logs pane.
+	ErrorSource = "messages"
+	// SearchErrorSource identifies errors of commands issued by the message search results pane.
+	SearchErrorSource = "message search"
+)
+
+const pageSize = 50
+
+var (
+	timeWindows = []timeWindow{
+		{"Last hour", time.Hour},
+		{"Last 24 hours", 24 * time.Hour},
+		{"Last 7 days", 7 * 24 * time.Hour},
+		{"Last 30 days", 30 * 24 * time.Hour},
+	}
+
+	statuses = []string{
+		"",
+		api.MessageStatusFailed,
+		api.MessageStatusRetry,
+		api.MessageStatusCompleted,
+	}
+)
+
+type MessageLogsPageMsg struct {
+	ArtifactID string
+	Logs       []api.MessageProcessingLog
+	More       bool
+	older      bool
+	ctx        context.Context
+}
+
+func New() *Model {
+	return newModel(false)
+}
+
+// NewSearch creates the pane for tenant-wide message search results, which lists the integration flow
+// of each message instead of its sender and receiver.
+func NewSearch() *Model {
+	return newModel(true)
+}
+
+func newModel(search bool) *Model {
+	common := common.New()
+
+	errorSource := ErrorSource
+	if search {
+		errorSource = SearchErrorSource
+	}
+
+	logs := list.New(make([]list.Item, 0), NewMessageLogItemDelegate(search), 0, 0)
+	logs.DisableQuitKeybindings()
+	logs.SetShowHelp(false)
+	logs.SetShowTitle(false)
+	logs.SetShowPagination(false)
+	logs.SetShowStatusBar(false)
+	logs.SetFilteringEnabled(false)
+	logs.SetStatusBarItemName("message", "messages")
+	logs.Styles.NoItems = common.Styles.MessagesPane.Dataset.NoItems
+
+	return &Model{
+		common:     common,
+		logs:       logs,
+		loader:     pane.NewLoader(errorSource, common.Styles.MessagesPane.Dataset.Loading),
+		search:     search,
+		timeWindow: 1,
+	}
+}
+
+func (*Model) Init() tea.Cmd {
+	return nil
+}
+
+func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var (
+		cmd  tea.Cmd
+		cmds = make([]tea.Cmd, 0)
+	)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		// Navigating past the last loaded log loads older logs.
+		case key.Matches(msg, model.common.KeyMap.Down) &&
+			model.logs.Index() == len(model.logs.Items())-1 && model.more && !model.loader.Loading():
+			cmds = append(cmds, model.olderMessageLogsCmd())
+
+		case key.Matches(msg, model.common.KeyMap.Up), key.Matches(msg, model.common.KeyMap.Down):
+			model.logs, cmd = model.logs.Update(msg)
+			cmds = append(cmds, cmd)
+
+		// Search results are restricted to the time range of the search criteria instead.
+		case key.Matches(msg, model.common.KeyMap.TimeWindow) && !model.search:
+			model.timeWindow = (model.timeWindow + 1) % len(timeWindows)
+			cmds = append(cmds, model.MessageLogsCmd())
+
+		case key.Matches(msg, model.common.KeyMap.StatusFilter):
+			model.status = (model.status + 1) % len(statuses)
+			cmds = append(cmds, model.MessageLogsCmd())
+
+		case key.Matches(msg, model.common.KeyMap.Refresh):
+			cmds = append(cmds, model.MessageLogsCmd())
+		}
+
+	case spinner.TickMsg, err.ErrorMsg, err.RetryMsg:
+		cmds = append(cmds, model.loader.Update(msg))
+
+	case MessageLogsPageMsg:
+		// The page belongs to another pane or to a load that has been superseded by a newer one.
+		if !model.loader.Done(msg.ctx) {
+			break
+		}
+
+		model.more = msg.More
+
+		index := model.logs.Index()
+		cmds = append(cmds,
+			statusbar.StatusMessageCmd(fmt.Sprintf("Loaded %d messages in %d ms",
+				len(msg.Logs), model.loader.Elapsed().Milliseconds())),
+			statusbar.RefreshedCmd("Messages", time.Now()),
+			model.logs.SetItems(convertLogsToListItems(msg.Logs)),
+		)
+
+		// Older logs are appended below the selected log, and the selection moves on to the first of them.
+		if msg.older && index < len(msg.Logs)-1 {
+			model.logs.Select(index + 1)
+		}
+	}
+
+	return model, tea.Batch(cmds...)
+}
+
+// SetSize resizes the pane, including its border.
+func (model *Model) SetSize(width, height int) {
+	styles := model.common.Styles.MessagesPane
+
+	model.width = max(1, width-styles.Area.GetHorizontalFrameSize())
+	model.common.Styles.MessagesPane.Area = styles.Area.
+		Width(model.width).
+		Height(max(0, height-styles.Area.GetVerticalFrameSize()))
+	model.common.Styles.MessagesPane.Title = styles.Title.Width(model.width)
+
+	// The title, the filters, the table header and the footer surround the list.
+	const reservedLines = 5
+
+	model.logs.SetSize(model.width, max(1, height-styles.Area.GetVerticalFrameSize()-reservedLines))
+	model.logs.Styles.NoItems = model.logs.Styles.NoItems.Width(model.width)
+}
+
+func (model *Model) View() string {
+	styles := model.common.Styles.MessagesPane
+
+	content := model.loader.View(model.logs, "Loading messages…")
+
+	title, lastColumn := "Messages of "+model.artifactName, "Sender → Receiver"
+	if model.search {
+		title, lastColumn = "Message search", "Integration flow"
+	}
+
+	return styles.Area.Render(lipgloss.JoinVertical(lipgloss.Left,
+		styles.Title.Render(title),
+		styles.Filters.Width(model.width).Render(
+			ansi.Truncate(model.filtersView(), model.width, "…"),
+		),
+		styles.Header.Width(model.width).Render(
+			row(model.width, styles.Header, styles.Header,
+				"Status", "Start (UTC)", "End (UTC)", "Duration", "Correlation ID", lastColumn),
+		),
+		lipgloss.NewStyle().Height(model.logs.Height()).Render(content),
+		styles.Footer.Width(model.width).Render(model.footerView()),
+	))
+}
+
+func (model *Model) filtersView() string {
+	status := statuses[model.status]
+	if status == "" {
+		status = "All"
+	}
+
+	if model.search {
+		return fmt.Sprintf("%s · Status: %s (%s)",
+			Criteria(model.criteria), status, model.common.KeyMap.StatusFilter.Help().Key)
+	}
+
+	return fmt.Sprintf("Time: %s (%s) · Status: %s (%s)",
+		timeWindows[model.timeWindow].label, model.common.KeyMap.TimeWindow.Help().Key,
+		status, model.common.KeyMap.StatusFilter.Help().Key)
+}
+
+// Criteria summarises the message search criteria.
+func Criteria(criteria api.MessageFilter) string {
+	parts := make([]string, 0)
+
+	if criteria.CustomHeaderName != "" || criteria.CustomHeaderValue != "" {
+		name, value := criteria.CustomHeaderName, criteria.CustomHeaderValue
+		if name == "" {
+			name = "*"
+		}
+
+		if value == "" {
+			value = "*"
+		}
+
+		parts = append(parts, fmt.Sprintf("Header: %s = %s", name, value))
+	}
+
+	if criteria.CorrelationID != "" {
+		parts = append(parts, "Correlation ID: "+criteria.CorrelationID)
+	}
+
+	if criteria.ApplicationMessageID != "" {
+		parts = append(parts, "Application ID: "+criteria.ApplicationMessageID)
+	}
+
+	from, to := "…", "…"
+	if !criteria.From.IsZero() {
+		from = criteria.From.UTC().Format(time.DateTime)
+	}
+
+	if !criteria.To.IsZero() {
+		to = criteria.To.UTC().Format(time.DateTime)
+	}
+
+	if !criteria.From.IsZero() || !criteria.To.IsZero() {
+		parts = append(parts, fmt.Sprintf("Time: %s – %s", from, to))
+	}
+
+	return strings.Join(parts, " · ")
+}
+
+func (model *Model) footerView() string {
+	count := len(model.logs.Items())
+
+	switch {
+	case model.loader.Loading() && count > 0:
+		return model.loader.Spinner() + " Loading messages…"
+	case model.more:
+		return fmt.Sprintf("%d messages loaded, navigate past the last one to load older messages", count)
+	case count > 0:
+		return fmt.Sprintf("%d messages, all loaded", count)
+	default:
+		return ""
+	}
+}
+
+// Open lists message processing logs of the integration flow.
+func (model *Model) Open(artifactID, artifactName string) tea.Cmd {
+	if artifactID != model.artifactID {
+		model.logs.SetItems(make([]list.Item, 0))
+	}
+
+	model.artifactID = artifactID
+	model.artifactName = artifactName
+
+	return model.MessageLogsCmd()
+}
+
+// Search lists message processing logs across the tenant that match the criteria.
+func (model *Model) Search(criteria api.MessageFilter) tea.Cmd {
+	model.logs.SetItems(make([]list.Item, 0))
+	model.criteria = criteria
+
+	return model.MessageLogsCmd()
+}
+
+// MessageLogsCmd loads the latest message processing logs that match the filters,
+// cancelling the load that is still in progress, if any.
+func (model *Model) MessageLogsCmd() tea.Cmd {
+	model.logs.ResetSelected()
+	model.filter = model.currentFilter()
+
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Fetching messages…"),
+		model.loader.Load(model.messageLogsPage(model.filter, make([]api.MessageProcessingLog, 0))),
+	)
+}
+
+// CancelCmds cancels the load that is still in progress, if any.
+func (model *Model) CancelCmds() {
+	model.loader.Cancel()
+}
+
+func (model *Model) olderMessageLogsCmd() tea.Cmd {
+	loaded := make([]api.MessageProcessingLog, 0, len(model.logs.Items()))
+	for _, item := range model.logs.Items() {
+		loaded = append(loaded, api.MessageProcessingLog(item.(Item)))
+	}
+
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Fetching older messages…"),
+		model.loader.Continue(model.messageLogsPage(model.filter, loaded)),
+	)
+}
+
+// currentFilter builds the filter of the latest logs. Its time range ends now, so that pages of older logs
+// that are loaded later on aren't shifted by logs that arrive in the meantime.
+func (model *Model) currentFilter() api.MessageFilter {
+	now := time.Now()
+
+	if model.search {
+		filter := model.criteria
+		filter.Status = statuses[model.status]
+
+		if filter.To.IsZero() {
+			filter.To = now
+		}
+
+		return filter
+	}
+
+	return api.MessageFilter{
+		ArtifactID: model.artifactID,
+		Status:     statuses[model.status],
+		From:       now.Add(-timeWindows[model.timeWindow].duration),
+		To:         now,
+	}
+}
+
+// messageLogsPage fetches the page of logs that match the filter and follow the ones already loaded.
+func (model *Model) messageLogsPage(filter api.MessageFilter,
+	loaded []api.MessageProcessingLog,
+) func(ctx context.Context) (tea.Msg, error) {
+	artifactID := model.artifactID
+
+	return func(ctx context.Context) (tea.Msg, error) {
+		logs, e := api.MessageProcessingLogsPage(ctx, filter, pageSize, len(loaded))
+		if e != nil {
+			return nil, e
+		}
+
+		return MessageLogsPageMsg{
+			ArtifactID: artifactID,
+			Logs:       appendNew(loaded, logs),
+			More:       len(logs) == pageSize,
+			older:      len(loaded) > 0,
+			ctx:        ctx,
+		}, nil
+	}
+}
+
+func (model *Model) selectedLogItem() list.Item {
+	return model.logs.SelectedItem()
+}
+
+// SelectedMessageLog returns the selected message processing log, if any.
+func (model *Model) SelectedMessageLog() *api.MessageProcessingLog {
+	selectedLogItem := model.selectedLogItem()
+	if selectedLogItem == nil {
+		return nil
+	}
+
+	log := api.MessageProcessingLog(selectedLogItem.(Item))
+
+	return &log
+}
+
+// SelectedMessageLogWebUIURL returns the link to the selected message in the Web UI's message monitor.
+func (model *Model) SelectedMessageLogWebUIURL() *url.URL {
+	log := model.SelectedMessageLog()
+	if log == nil {
+		return nil
+	}
+
+	return WebUIURL(*log)
+}
+
+// WebUIURL returns the link to the message in the Web UI's message monitor, if the tenant provides it.
+func WebUIURL(log api.MessageProcessingLog) *url.URL {
+	if log.AlternateWebLink == "" {
+		return nil
+	}
+
+	webLink, e := url.Parse(log.AlternateWebLink)
+	if e != nil {
+		return nil
+	}
+
+	return webLink
+}
+
+// appendNew appends the logs that haven't been loaded yet. A log that is added to the tenant while pages are
+// loaded shifts the following pages, so that the last logs of a page are repeated on the next one.
+func appendNew(loaded, logs []api.MessageProcessingLog) []api.MessageProcessingLog {
+	guids := make(map[string]bool, len(loaded))
+	for _, log := range loaded {
+		guids[log.MessageGUID] = true
+	}
+
+	for _, log := range logs {
+		if !guids[log.MessageGUID] {
+			guids[log.MessageGUID] = true
+			loaded = append(loaded, log)
+		}
+	}
+
+	return loaded
+}
+
+func convertLogsToListItems(logs []api.MessageProcessingLog) []list.Item {
+	items := make([]list.Item, 0, len(logs))
+	for _, log := range logs {
+		items = append(items, Item(log))
+	}
+
+	return items
+}
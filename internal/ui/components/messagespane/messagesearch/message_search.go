@@ -0,0 +1,252 @@
+package messagesearch
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+)
+
+// Model is a form for the criteria of a tenant-wide message search.
+type Model struct {
+	common  common.Common
+	inputs  []textinput.Model
+	focused int
+	opened  bool
+	err     error
+	width   int
+}
+
+type (
+	// SubmitMsg carries the criteria of the search to run.
+	SubmitMsg api.MessageFilter
+	CloseMsg  struct{}
+)
+
+const (
+	headerNameField = iota
+	headerValueField
+	correlationIDField
+	applicationMessageIDField
+	fromField
+	toField
+)
+
+const labelWidth = 24
+
+// Time range of the search is entered in UTC using one of these layouts.
+var timeLayouts = []string{
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	time.DateOnly,
+}
+
+var labels = []string{
+	headerNameField:           "Custom header name",
+	headerValueField:          "Custom header value",
+	correlationIDField:        "Correlation ID",
+	applicationMessageIDField: "Application message ID",
+	fromField:                 "From (UTC)",
+	toField:                   "To (UTC)",
+}
+
+func New() *Model {
+	common := common.New()
+	styles := common.Styles.MessageSearch
+
+	placeholders := []string{
+		headerNameField:           "e.g. OrderNumber",
+		headerValueField:          "e.g. 4711",
+		correlationIDField:        "",
+		applicationMessageIDField: "",
+		fromField:                 "YYYY-MM-DD hh:mm",
+		toField:                   "YYYY-MM-DD hh:mm",
+	}
+
+	inputs := make([]textinput.Model, 0, len(labels))
+
+	for _, placeholder := range placeholders {
+		input := textinput.New()
+		input.Prompt = ""
+		input.Placeholder = placeholder
+		input.TextStyle = styles.Input
+		input.PlaceholderStyle = styles.Placeholder
+		inputs = append(inputs, input)
+	}
+
+	return &Model{
+		common: common,
+		inputs: inputs,
+	}
+}
+
+func (*Model) Init() tea.Cmd {
+	return nil
+}
+
+func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var (
+		cmd  tea.Cmd
+		cmds = make([]tea.Cmd, 0)
+	)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, model.common.KeyMap.Close):
+			cmds = append(cmds, closeCmd)
+
+		case key.Matches(msg, model.common.KeyMap.Up):
+			cmds = append(cmds, model.focus((model.focused-1+len(model.inputs))%len(model.inputs)))
+
+		case key.Matches(msg, model.common.KeyMap.Down), key.Matches(msg, model.common.KeyMap.Tab):
+			cmds = append(cmds, model.focus((model.focused+1)%len(model.inputs)))
+
+		case key.Matches(msg, model.common.KeyMap.Enter):
+			criteria, e := model.criteria()
+			if e != nil {
+				model.err = e
+
+				break
+			}
+
+			model.err = nil
+			cmds = append(cmds, func() tea.Msg { return SubmitMsg(criteria) })
+
+		default:
+			model.inputs[model.focused], cmd = model.inputs[model.focused].Update(msg)
+			cmds = append(cmds, cmd)
+		}
+
+	default:
+		model.inputs[model.focused], cmd = model.inputs[model.focused].Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	return model, tea.Batch(cmds...)
+}
+
+// SetSize sets the size of the form, including its border.
+func (model *Model) SetSize(width, _ int) {
+	const maxWidth = 80
+
+	model.width = min(maxWidth, width)
+
+	for idx := range model.inputs {
+		model.inputs[idx].Width = max(1, model.contentWidth()-labelWidth-1)
+	}
+}
+
+// Open focuses the form, keeping criteria of the previous search. When the form is opened for the first time,
+// the search is restricted to the last 24 hours.
+func (model *Model) Open() tea.Cmd {
+	if !model.opened {
+		model.opened = true
+		model.inputs[fromField].SetValue(time.Now().UTC().Add(-24 * time.Hour).Format("2006-01-02 15:04"))
+	}
+
+	model.err = nil
+
+	return model.focus(model.focused)
+}
+
+func (model *Model) View() string {
+	styles := model.common.Styles.MessageSearch
+	width := model.contentWidth()
+
+	lines := []string{styles.Title.Render("Message search"), ""}
+
+	for idx, input := range model.inputs {
+		labelStyle := styles.Label
+		if idx == model.focused {
+			labelStyle = styles.Focused
+		}
+
+		lines = append(lines, labelStyle.Width(labelWidth).Render(labels[idx])+" "+input.View())
+	}
+
+	status := styles.Hint.Render(ansi.Truncate(fmt.Sprintf("%s next field · %s search · %s cancel",
+		model.common.KeyMap.Tab.Help().Key, model.common.KeyMap.Enter.Help().Key,
+		model.common.KeyMap.Close.Help().Key), width, "…"))
+	if model.err != nil {
+		status = styles.Error.Render(ansi.Truncate(model.err.Error(), width, "…"))
+	}
+
+	lines = append(lines, "", status)
+
+	return styles.Area.Width(model.width - styles.Area.GetHorizontalBorderSize()).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+func (model *Model) focus(idx int) tea.Cmd {
+	model.inputs[model.focused].Blur()
+	model.focused = idx
+
+	return model.inputs[idx].Focus()
+}
+
+// criteria validates the form and converts it to the filter of message processing logs.
+func (model *Model) criteria() (api.MessageFilter, error) {
+	value := func(field int) string {
+		return strings.TrimSpace(model.inputs[field].Value())
+	}
+
+	criteria := api.MessageFilter{
+		CustomHeaderName:     value(headerNameField),
+		CustomHeaderValue:    value(headerValueField),
+		CorrelationID:        value(correlationIDField),
+		ApplicationMessageID: value(applicationMessageIDField),
+	}
+
+	if criteria.CustomHeaderName == "" && criteria.CustomHeaderValue == "" &&
+		criteria.CorrelationID == "" && criteria.ApplicationMessageID == "" {
+		return criteria, errors.New("enter a custom header, a correlation ID or an application message ID")
+	}
+
+	var e error
+
+	if criteria.From, e = parseTime(labels[fromField], value(fromField)); e != nil {
+		return criteria, e
+	}
+
+	if criteria.To, e = parseTime(labels[toField], value(toField)); e != nil {
+		return criteria, e
+	}
+
+	if !criteria.From.IsZero() && !criteria.To.IsZero() && criteria.To.Before(criteria.From) {
+		return criteria, errors.New("the end of the time range precedes its start")
+	}
+
+	return criteria, nil
+}
+
+func (model *Model) contentWidth() int {
+	return max(1, model.width-model.common.Styles.MessageSearch.Area.GetHorizontalFrameSize())
+}
+
+// parseTime parses the time entered in UTC. An empty value doesn't restrict the time range.
+func parseTime(label, value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	for _, layout := range timeLayouts {
+		if t, e := time.ParseInLocation(layout, value, time.UTC); e == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%s: enter time as YYYY-MM-DD hh:mm", label)
+}
+
+func closeCmd() tea.Msg {
+	return CloseMsg{}
+}
@@ -7,9 +7,9 @@ import (
 	"github.com/charmbracelet/bubbles/list"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/muesli/reflow/truncate"
 	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/filter"
 )
 
 type Item api.ContentPackage
@@ -19,7 +19,7 @@ type ItemDelegate struct {
 }
 
 func (item Item) FilterValue() string {
-	return item.Name
+	return filter.Value(item.Name, item.ID, item.ShortText, item.Keywords)
 }
 
 func NewContentPackageItemDelegate() ItemDelegate {
@@ -50,7 +50,8 @@ func (itemDelegate ItemDelegate) Render(writer io.Writer, model list.Model, inde
 		style = itemDelegate.common.Styles.ContentPackagesPane.Dataset.Item.Normal
 	}
 
+	style = style.Width(model.Width()).MaxWidth(model.Width())
 	width := model.Width() - style.GetHorizontalFrameSize()
-	content := truncate.StringWithTail(item.Name, uint(width), "…")
-	fmt.Fprint(writer, style.Render(content))
+	fmt.Fprint(writer, filter.Render(item.Name, width, model.MatchesForItem(index), style,
+		itemDelegate.common.Styles.ContentPackagesPane.Dataset.Item.Match))
 }
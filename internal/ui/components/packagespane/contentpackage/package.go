@@ -1,27 +1,45 @@
 package contentpackage
 
 import (
+	"context"
+	"fmt"
 	"net/url"
 	"slices"
 	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/vadimklimov/cpi-navigator/internal/config"
 	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/filter"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/pane"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/common/sort"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/components/attributespane/attribute"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
 )
 
 type Model struct {
 	common   common.Common
 	packages list.Model
+	loader   pane.Loader
 }
 
-type ContentPackagesMsg []api.ContentPackage
+// ErrorSource identifies errors of commands issued by the content packages pane.
+const ErrorSource = "packages"
+
+type (
+	ContentPackagesMsg     []api.ContentPackage
+	ContentPackagesPageMsg struct {
+		Packages []api.ContentPackage
+		Next     string
+		ctx      context.Context
+	}
+	FilterMatchesMsg list.FilterMatchesMsg
+)
 
 func New() *Model {
 	common := common.New()
@@ -34,12 +52,12 @@ func New() *Model {
 		list.DisableQuitKeybindings()
 		list.SetShowHelp(false)
 		list.SetShowTitle(false)
-		list.SetFilteringEnabled(false)
 		list.SetShowPagination(false)
 		list.SetShowStatusBar(false)
 		list.SetStatusBarItemName("package", "packages")
 		list.InfiniteScrolling = true
 		list.Styles.NoItems = common.Styles.ContentPackagesPane.Dataset.NoItems
+		filter.Setup(&list, common.Styles.ContentPackagesPane.Dataset.Filter)
 
 		return list
 	}
@@ -47,11 +65,12 @@ func New() *Model {
 	return &Model{
 		common:   common,
 		packages: init(),
+		loader:   pane.NewLoader(ErrorSource, common.Styles.ContentPackagesPane.Dataset.Loading),
 	}
 }
 
 func (model *Model) Init() tea.Cmd {
-	return model.ContentPackagesCmd
+	return model.ContentPackagesCmd()
 }
 
 func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -63,32 +82,116 @@ func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
 		switch {
-		case key.Matches(msg, model.common.KeyMap.Up), key.Matches(msg, model.common.KeyMap.Down):
+		case model.packages.SettingFilter(),
+			key.Matches(msg, model.common.KeyMap.Up),
+			key.Matches(msg, model.common.KeyMap.Down),
+			key.Matches(msg, model.common.KeyMap.Filter),
+			key.Matches(msg, model.common.KeyMap.ClearFilter):
 			model.packages, cmd = model.packages.Update(msg)
 			if cmd != nil {
-				cmds = append(cmds, cmd)
+				cmds = append(cmds, filter.Cmd(cmd, filterMatchesMsg))
+			}
+
+			filter.SyncView(&model.packages)
+		}
+
+	case FilterMatchesMsg:
+		model.packages, cmd = model.packages.Update(list.FilterMatchesMsg(msg))
+		cmds = append(cmds, cmd)
+
+	case spinner.TickMsg, err.ErrorMsg, err.RetryMsg:
+		cmds = append(cmds, model.loader.Update(msg))
+
+	case ContentPackagesPageMsg:
+		if msg.Next != "" {
+			// The page belongs to a load that has been superseded by a newer one.
+			if !model.loader.Current(msg.ctx) {
+				break
 			}
+
+			cmds = append(cmds,
+				statusbar.StatusMessageCmd(fmt.Sprintf("Fetching content packages… %d loaded", len(msg.Packages))),
+				model.loader.Continue(contentPackagesPage(msg.Packages, msg.Next)),
+			)
+
+			break
+		}
+
+		if !model.loader.Done(msg.ctx) {
+			break
 		}
 
+		cmds = append(cmds,
+			statusbar.StatusMessageCmd(fmt.Sprintf("Loaded %d content packages in %d ms",
+				len(msg.Packages), model.loader.Elapsed().Milliseconds())),
+			func() tea.Msg { return ContentPackagesMsg(msg.Packages) },
+		)
+
 	case ContentPackagesMsg:
-		model.packages.SetItems(convertPackagesToListItems(msg))
+		cmds = append(cmds,
+			statusbar.RefreshedCmd("Packages", time.Now()),
+			filter.Cmd(model.packages.SetItems(convertPackagesToListItems(msg)), filterMatchesMsg),
+		)
 		model.packages.ResetSelected()
 	}
 
 	return model, tea.Batch(cmds...)
 }
 
+// SetSize resizes the list of content packages.
+func (model *Model) SetSize(width, height int) {
+	model.packages.SetSize(width, height)
+	model.packages.Styles.NoItems = model.packages.Styles.NoItems.Width(width)
+}
+
+// Filtering reports whether the filter is being edited, in which case all keys are consumed by the filter input.
+func (model *Model) Filtering() bool {
+	return model.packages.SettingFilter()
+}
+
 func (model *Model) View() string {
-	return model.packages.View()
+	return model.loader.View(model.packages, "Loading content packages…")
+}
+
+// ContentPackagesCmd loads content packages, cancelling the load that is still in progress, if any.
+func (model *Model) ContentPackagesCmd() tea.Cmd {
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Fetching content packages…"),
+		model.loader.Load(contentPackagesPage(make([]api.ContentPackage, 0), "")),
+	)
+}
+
+// contentPackagesPage fetches the page of content packages that follows the ones already loaded.
+func contentPackagesPage(loaded []api.ContentPackage, next string) func(ctx context.Context) (tea.Msg, error) {
+	return func(ctx context.Context) (tea.Msg, error) {
+		page, e := api.ContentPackagesPage(ctx, next)
+		if e != nil {
+			return nil, e
+		}
+
+		return ContentPackagesPageMsg{
+			Packages: append(loaded, page.Results...),
+			Next:     page.Next,
+			ctx:      ctx,
+		}, nil
+	}
 }
 
-func (*Model) ContentPackagesCmd() tea.Msg {
-	packages, e := api.ContentPackages()
-	if e != nil {
-		return err.ErrorMsg(e)
+// SelectPackage clears the filter and selects the content package, reporting whether it is in the list.
+func (model *Model) SelectPackage(packageID string) bool {
+	idx := slices.IndexFunc(model.packages.Items(), func(item list.Item) bool {
+		return item.(Item).ID == packageID
+	})
+
+	if idx == -1 {
+		return false
 	}
 
-	return ContentPackagesMsg(packages)
+	model.packages.ResetFilter()
+	filter.SyncView(&model.packages)
+	model.packages.Select(idx)
+
+	return true
 }
 
 func (model *Model) selectedPackageItem() list.Item {
@@ -106,6 +209,17 @@ func (model *Model) SelectedPackageID() *string {
 	return &selectedPackage.ID
 }
 
+func (model *Model) SelectedPackageName() *string {
+	selectedPackageItem := model.selectedPackageItem()
+	if selectedPackageItem == nil {
+		return nil
+	}
+
+	selectedPackage := selectedPackageItem.(Item)
+
+	return &selectedPackage.Name
+}
+
 func (model *Model) SelectedPackageAttributes() []attribute.Attribute {
 	selectedPackageItem := model.selectedPackageItem()
 	if selectedPackageItem == nil {
@@ -144,6 +258,10 @@ func (model *Model) SelectedPackageWebUIURL() *url.URL {
 	return tenantWorkspaceWebUIURL.JoinPath("contentpackage", *selectedPackageID)
 }
 
+func filterMatchesMsg(msg list.FilterMatchesMsg) tea.Msg {
+	return FilterMatchesMsg(msg)
+}
+
 func convertPackagesToListItems(packages []api.ContentPackage) []list.Item {
 	sort.Sort(packages, sort.Options{
 		Field: config.UIPackagesPaneSortField(),
@@ -0,0 +1,210 @@
+package queue
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/config"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/format"
+)
+
+type (
+	QueueItem   api.Queue
+	MessageItem api.QueueMessage
+)
+
+type ItemDelegate struct {
+	common common.Common
+}
+
+const (
+	stateColumnWidth    = 12
+	countColumnWidth    = 8
+	sizeColumnWidth     = 10
+	usageColumnWidth    = 6
+	overflowColumnWidth = 8
+	retriesColumnWidth  = 7
+	dateColumnWidth     = 16
+	columnGap           = 1
+	dateTimeLayout      = "2006-01-02 15:04"
+)
+
+func (item QueueItem) FilterValue() string {
+	return item.Name
+}
+
+func (item MessageItem) FilterValue() string {
+	return item.ID
+}
+
+func NewQueueItemDelegate() ItemDelegate {
+	return ItemDelegate{
+		common: common.New(),
+	}
+}
+
+func (ItemDelegate) Height() int {
+	return 1
+}
+
+func (ItemDelegate) Spacing() int {
+	return 0
+}
+
+func (ItemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd {
+	return nil
+}
+
+func (itemDelegate ItemDelegate) Render(writer io.Writer, model list.Model, index int, listItem list.Item) {
+	styles := itemDelegate.common.Styles.QueuesPane.Dataset
+
+	var style lipgloss.Style
+
+	switch {
+	case index == model.Index():
+		style = styles.Item.Selected
+	case failed(listItem):
+		style = styles.Item.Failed
+	case nearlyFull(listItem):
+		style = styles.Item.Warning
+	default:
+		style = styles.Item.Normal
+	}
+
+	cell := lipgloss.NewStyle().
+		Background(style.GetBackground()).
+		Foreground(style.GetForeground())
+
+	var line string
+
+	switch item := listItem.(type) {
+	case QueueItem:
+		line = queueRow(model.Width(), cell, item.Name, item.State, strconv.Itoa(item.NumberOfMessages),
+			format.Size(item.Size), usage(api.Queue(item)), flag(item.Overflow))
+
+	case MessageItem:
+		line = messageRow(model.Width(), cell, item.ID, item.MessageID, strconv.Itoa(item.RetryCount),
+			dateTime(item.NextRetry), dateTime(item.CreatedAt), oneLine(item.FailureReason))
+	}
+
+	fmt.Fprint(writer, style.Width(model.Width()).MaxWidth(model.Width()).Render(line))
+}
+
+// failed reports whether the queue has overflowed, or whether the message failed to be processed.
+func failed(listItem list.Item) bool {
+	switch item := listItem.(type) {
+	case QueueItem:
+		return item.Overflow
+	case MessageItem:
+		return item.Failed
+	default:
+		return false
+	}
+}
+
+// nearlyFull reports whether usage of the queue has reached the warning threshold of the configuration.
+func nearlyFull(listItem list.Item) bool {
+	item, ok := listItem.(QueueItem)
+
+	return ok && isNearlyFull(api.Queue(item))
+}
+
+func isNearlyFull(queue api.Queue) bool {
+	return queue.Usage() >= config.UIQueuesUsageWarningPercent()
+}
+
+// queueRow lays out the name, state, message count, size, usage and overflow columns of the queues table
+// within the width.
+func queueRow(width int, style lipgloss.Style, name, state, messages, size, usage, overflow string) string {
+	const columns = 6
+
+	nameWidth := max(0, width-stateColumnWidth-countColumnWidth-sizeColumnWidth-usageColumnWidth-
+		overflowColumnWidth-(columns-1)*columnGap)
+
+	return cells(style,
+		column{name, nameWidth, false},
+		column{state, stateColumnWidth, false},
+		column{messages, countColumnWidth, true},
+		column{size, sizeColumnWidth, true},
+		column{usage, usageColumnWidth, true},
+		column{overflow, overflowColumnWidth, true},
+	)
+}
+
+// messageRow lays out the ID, message ID, retry count, dates and failure reason columns of the queue messages
+// table within the width.
+func messageRow(width int, style lipgloss.Style, id, messageID, retries, nextRetry, createdAt, reason string) string {
+	const columns = 6
+
+	remaining := max(0, width-retriesColumnWidth-2*dateColumnWidth-(columns-1)*columnGap)
+	idWidth := remaining / 4
+	messageIDWidth := remaining / 4
+	reasonWidth := remaining - idWidth - messageIDWidth
+
+	return cells(style,
+		column{id, idWidth, false},
+		column{messageID, messageIDWidth, false},
+		column{retries, retriesColumnWidth, true},
+		column{nextRetry, dateColumnWidth, false},
+		column{createdAt, dateColumnWidth, false},
+		column{reason, reasonWidth, false},
+	)
+}
+
+type column struct {
+	value      string
+	width      int
+	alignRight bool
+}
+
+func cells(style lipgloss.Style, columns ...column) string {
+	rendered := make([]string, 0, len(columns))
+
+	for _, column := range columns {
+		cellStyle := style.Width(column.width)
+		if column.alignRight {
+			cellStyle = cellStyle.AlignHorizontal(lipgloss.Right)
+		}
+
+		rendered = append(rendered, cellStyle.Render(ansi.Truncate(column.value, column.width, "…")))
+	}
+
+	return strings.Join(rendered, style.Render(strings.Repeat(" ", columnGap)))
+}
+
+func usage(queue api.Queue) string {
+	if queue.Usage() < 0 {
+		return ""
+	}
+
+	return strconv.Itoa(queue.Usage()) + "%"
+}
+
+func flag(value bool) string {
+	if value {
+		return "yes"
+	}
+
+	return ""
+}
+
+// oneLine joins lines of the text, so that it fits in a table cell.
+func oneLine(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
+
+func dateTime(value api.DateTime) string {
+	if value.IsZero() {
+		return ""
+	}
+
+	return value.UTC().Format(dateTimeLayout)
+}
@@ -0,0 +1,594 @@
+package queue
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/config"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/format"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/pane"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
+)
+
+// Model lists JMS queues of the runtime and, once a queue is opened, its messages. In write mode, messages
+// can be retried, moved to another queue and deleted. To move a message, the target queue is chosen
+// from the list of queues.
+type Model struct {
+	common   common.Common
+	queues   list.Model
+	messages list.Model
+	queue    *api.Queue
+	moving   *api.QueueMessage
+	loader   pane.Loader
+	width    int
+}
+
+const (
+	// ErrorSource identifies errors of commands that load queues and their messages.
+	ErrorSource = "queues"
+	// RetryErrorSource, MoveErrorSource and DeleteErrorSource identify errors of commands that retry, move
+	// and delete queue messages. These commands aren't run again with the retry key, as they have to be confirmed.
+	RetryErrorSource  = "queue message retry"
+	MoveErrorSource   = "queue message move"
+	DeleteErrorSource = "queue message deletion"
+)
+
+// detailLines is the height of the details of the selected queue or message below the list.
+const detailLines = 3
+
+type (
+	QueuesMsg struct {
+		Queues []api.Queue
+		ctx    context.Context
+	}
+	MessagesMsg struct {
+		Queue    api.Queue
+		Messages []api.QueueMessage
+		ctx      context.Context
+	}
+	// RetriedMsg reports that the message has been delivered from its queue again.
+	RetriedMsg struct {
+		Message api.QueueMessage
+	}
+	// MovedMsg reports that the message has been moved to the target queue.
+	MovedMsg struct {
+		Message api.QueueMessage
+		Target  string
+	}
+	// DeletedMsg reports that the message has been deleted from its queue.
+	DeletedMsg struct {
+		Message api.QueueMessage
+	}
+)
+
+func New() *Model {
+	common := common.New()
+	styles := common.Styles.QueuesPane
+
+	init := func(singular, plural string) list.Model {
+		list := list.New(make([]list.Item, 0), NewQueueItemDelegate(), 0, 0)
+		list.DisableQuitKeybindings()
+		list.SetShowHelp(false)
+		list.SetShowTitle(false)
+		list.SetShowPagination(false)
+		list.SetShowStatusBar(false)
+		list.SetFilteringEnabled(false)
+		list.SetStatusBarItemName(singular, plural)
+		list.Styles.NoItems = styles.Dataset.NoItems
+
+		return list
+	}
+
+	return &Model{
+		common:   common,
+		queues:   init("queue", "queues"),
+		messages: init("message", "messages"),
+		loader:   pane.NewLoader(ErrorSource, styles.Dataset.Loading),
+	}
+}
+
+func (*Model) Init() tea.Cmd {
+	return nil
+}
+
+func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var (
+		cmd  tea.Cmd
+		cmds = make([]tea.Cmd, 0)
+	)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, model.common.KeyMap.Up), key.Matches(msg, model.common.KeyMap.Down):
+			dataset := model.activeList()
+			*dataset, cmd = dataset.Update(msg)
+			cmds = append(cmds, cmd)
+
+		case key.Matches(msg, model.common.KeyMap.Enter):
+			if queue := model.SelectedQueue(); model.queue == nil && queue != nil {
+				cmds = append(cmds, model.MessagesCmd(*queue))
+			}
+
+		case key.Matches(msg, model.common.KeyMap.Refresh):
+			if model.queue != nil && model.moving == nil {
+				cmds = append(cmds, model.MessagesCmd(*model.queue))
+			} else {
+				cmds = append(cmds, model.QueuesCmd())
+			}
+		}
+
+	case spinner.TickMsg, err.ErrorMsg, err.RetryMsg:
+		cmds = append(cmds, model.loader.Update(msg))
+
+	case QueuesMsg:
+		if !model.loader.Done(msg.ctx) {
+			break
+		}
+
+		items := make([]list.Item, 0, len(msg.Queues))
+		for _, queue := range sortedQueues(msg.Queues) {
+			items = append(items, QueueItem(queue))
+		}
+
+		cmds = append(cmds,
+			statusbar.StatusMessageCmd(fmt.Sprintf("Loaded %d queues in %d ms",
+				len(msg.Queues), model.loader.Elapsed().Milliseconds())),
+			statusbar.RefreshedCmd("Queues", time.Now()),
+			model.queues.SetItems(items),
+		)
+
+	case MessagesMsg:
+		if !model.loader.Done(msg.ctx) {
+			break
+		}
+
+		items := make([]list.Item, 0, len(msg.Messages))
+		for _, message := range sortedMessages(msg.Messages) {
+			items = append(items, MessageItem(message))
+		}
+
+		model.updateQueue(msg.Queue.Name, func(item *QueueItem) {
+			item.NumberOfMessages = len(msg.Messages)
+		})
+
+		cmds = append(cmds,
+			statusbar.StatusMessageCmd(fmt.Sprintf("Loaded %d messages of queue %s in %d ms",
+				len(msg.Messages), msg.Queue.Name, model.loader.Elapsed().Milliseconds())),
+			model.messages.SetItems(items),
+		)
+
+	case RetriedMsg:
+		cmds = append(cmds, statusbar.StatusMessageCmd(fmt.Sprintf("Retried message %s of queue %s",
+			msg.Message.ID, msg.Message.QueueName)))
+
+		// The message is either delivered or fails again, so its state is loaded anew.
+		if model.queue != nil && model.moving == nil && model.queue.Name == msg.Message.QueueName {
+			cmds = append(cmds, model.MessagesCmd(*model.queue))
+		}
+
+	case MovedMsg:
+		model.removeMessage(msg.Message)
+		model.updateQueue(msg.Target, func(item *QueueItem) {
+			item.NumberOfMessages++
+		})
+
+		cmds = append(cmds, statusbar.StatusMessageCmd(fmt.Sprintf("Moved message %s from queue %s to queue %s",
+			msg.Message.ID, msg.Message.QueueName, msg.Target)))
+
+	case DeletedMsg:
+		model.removeMessage(msg.Message)
+
+		cmds = append(cmds, statusbar.StatusMessageCmd(fmt.Sprintf("Deleted message %s of queue %s",
+			msg.Message.ID, msg.Message.QueueName)))
+	}
+
+	return model, tea.Batch(cmds...)
+}
+
+// SetSize resizes the pane, including its border.
+func (model *Model) SetSize(width, height int) {
+	styles := model.common.Styles.QueuesPane
+
+	model.width = max(1, width-styles.Area.GetHorizontalFrameSize())
+	model.common.Styles.QueuesPane.Area = styles.Area.
+		Width(model.width).
+		Height(max(0, height-styles.Area.GetVerticalFrameSize()))
+	model.common.Styles.QueuesPane.Title = styles.Title.Width(model.width)
+
+	// The title, the table header, the details of the selected item and the footer surround the list.
+	const reservedLines = 5 + detailLines
+
+	for _, dataset := range []*list.Model{&model.queues, &model.messages} {
+		pane.FitList(dataset, model.width, height-styles.Area.GetVerticalFrameSize(), reservedLines)
+	}
+}
+
+func (model *Model) View() string {
+	styles := model.common.Styles.QueuesPane
+	dataset := model.activeList()
+
+	var (
+		title, header string
+		loading       = "Loading queues…"
+	)
+
+	switch {
+	case model.moving != nil:
+		title = fmt.Sprintf("Move message %s of queue %s to", model.moving.ID, model.moving.QueueName)
+		header = queueRow(model.width, styles.Header, "Queue", "State", "Messages", "Size", "Usage", "Overflow")
+
+	case model.queue != nil:
+		title = "Messages of queue " + model.queue.Name
+		header = messageRow(model.width, styles.Header,
+			"JMS message ID", "Message ID", "Retries", "Next retry", "Created at", "Failure reason")
+		loading = "Loading messages…"
+
+	default:
+		title = "JMS queues"
+		header = queueRow(model.width, styles.Header, "Queue", "State", "Messages", "Size", "Usage", "Overflow")
+	}
+
+	return styles.Area.Render(lipgloss.JoinVertical(lipgloss.Left,
+		styles.Title.Render(ansi.Truncate(title, model.width, "…")),
+		styles.Header.Width(model.width).Render(header),
+		lipgloss.NewStyle().Height(dataset.Height()).Render(model.loader.View(*dataset, loading)),
+		model.detailView(),
+		styles.Footer.Width(model.width).Render(ansi.Truncate(model.footerView(), model.width, "…")),
+	))
+}
+
+// detailView describes the selected queue, or the failure of the selected message in full.
+func (model *Model) detailView() string {
+	styles := model.common.Styles.QueuesPane
+
+	var text string
+
+	switch {
+	case model.moving == nil && model.queue != nil:
+		if message := model.SelectedMessage(); message != nil {
+			switch {
+			case message.FailureReason != "":
+				text = "Failure reason: " + message.FailureReason
+			case message.Failed:
+				text = "Message " + message.ID + " failed, the reason isn't reported"
+			default:
+				text = "Message " + message.ID + " hasn't failed"
+			}
+		}
+
+	default:
+		if queue := model.SelectedQueue(); queue != nil {
+			text = describeQueue(*queue)
+		}
+	}
+
+	lines := strings.Split(ansi.Wrap(text, model.width, ""), "\n")
+	if len(lines) > detailLines {
+		lines = append(lines[:detailLines-1], ansi.Truncate(lines[detailLines-1]+"…", model.width, "…"))
+	}
+
+	return styles.Detail.Width(model.width).
+		Height(detailLines).
+		Border(lipgloss.NormalBorder(), true, false, false, false).
+		BorderForeground(styles.Area.GetBorderTopForeground()).
+		Render(strings.Join(lines, "\n"))
+}
+
+func (model *Model) footerView() string {
+	keys := model.common.KeyMap
+
+	switch {
+	case model.loader.Loading() && len(model.activeList().Items()) > 0:
+		return model.loader.Spinner() + " Loading…"
+
+	case model.moving != nil:
+		return fmt.Sprintf("%s move to the selected queue · %s cancel", keys.Enter.Help().Key, keys.Close.Help().Key)
+
+	case model.queue != nil && len(model.messages.Items()) > 0:
+		failed := 0
+
+		for _, item := range model.messages.Items() {
+			if item.(MessageItem).Failed {
+				failed++
+			}
+		}
+
+		footer := fmt.Sprintf("%d messages · %d failed", len(model.messages.Items()), failed)
+		if config.TenantWriteMode() {
+			footer += fmt.Sprintf(" · %s retry · %s move · %s delete",
+				keys.RetryMessage.Help().Key, keys.Move.Help().Key, keys.Delete.Help().Key)
+		}
+
+		return footer + fmt.Sprintf(" · %s back", keys.Close.Help().Key)
+
+	case model.queue == nil && len(model.queues.Items()) > 0:
+		messages, nearlyFull, overflowed := 0, 0, 0
+
+		for _, item := range model.queues.Items() {
+			queue := api.Queue(item.(QueueItem))
+			messages += queue.NumberOfMessages
+
+			if isNearlyFull(queue) {
+				nearlyFull++
+			}
+
+			if queue.Overflow {
+				overflowed++
+			}
+		}
+
+		return fmt.Sprintf("%d queues · %d messages · %d at %d%% usage or more · %d overflowed · %s messages of queue · %s back",
+			len(model.queues.Items()), messages, nearlyFull, config.UIQueuesUsageWarningPercent(), overflowed,
+			keys.Enter.Help().Key, keys.Close.Help().Key)
+
+	default:
+		return ""
+	}
+}
+
+// Open lists JMS queues of the runtime.
+func (model *Model) Open() tea.Cmd {
+	model.queue = nil
+	model.moving = nil
+
+	return model.QueuesCmd()
+}
+
+// Back cancels the choice of the queue to move a message to, or returns from messages of the queue to the list
+// of queues. It reports whether there was anything to return from.
+func (model *Model) Back() bool {
+	switch {
+	case model.moving != nil:
+		model.moving = nil
+
+		return true
+
+	case model.queue != nil:
+		model.loader.Reset()
+		model.queue = nil
+
+		return true
+
+	default:
+		return false
+	}
+}
+
+// ChooseTarget starts choosing the queue to move the selected message to from the list of queues.
+func (model *Model) ChooseTarget() {
+	model.moving = model.SelectedMessage()
+}
+
+// ChoosingTarget reports whether the queue to move a message to is being chosen.
+func (model *Model) ChoosingTarget() bool {
+	return model.moving != nil
+}
+
+// MovingMessage returns the message whose target queue is being chosen, if any.
+func (model *Model) MovingMessage() *api.QueueMessage {
+	return model.moving
+}
+
+// QueuesCmd loads queues, cancelling the load that is still in progress, if any.
+func (model *Model) QueuesCmd() tea.Cmd {
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Fetching queues…"),
+		model.loader.Load(func(ctx context.Context) (tea.Msg, error) {
+			queues, e := api.Queues(ctx)
+
+			return QueuesMsg{
+				Queues: queues,
+				ctx:    ctx,
+			}, e
+		}),
+	)
+}
+
+// MessagesCmd opens the queue and loads its messages, cancelling the load that is still in progress, if any.
+func (model *Model) MessagesCmd(queue api.Queue) tea.Cmd {
+	if model.queue == nil || model.queue.Name != queue.Name {
+		model.messages.SetItems(nil)
+		model.messages.ResetSelected()
+	}
+
+	model.queue = &queue
+
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Fetching messages of queue "+queue.Name+"…"),
+		model.loader.Load(func(ctx context.Context) (tea.Msg, error) {
+			messages, e := api.QueueMessages(ctx, queue)
+
+			return MessagesMsg{
+				Queue:    queue,
+				Messages: messages,
+				ctx:      ctx,
+			}, e
+		}),
+	)
+}
+
+// CancelCmds cancels the load that is still in progress, if any.
+func (model *Model) CancelCmds() {
+	model.loader.Cancel()
+}
+
+// RetryCmd delivers the selected message from its queue again.
+func (model *Model) RetryCmd() tea.Cmd {
+	message := model.SelectedMessage()
+	if message == nil {
+		return nil
+	}
+
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Retrying message "+message.ID+"…"),
+		func() tea.Msg {
+			if e := api.RetryQueueMessage(context.Background(), *message); e != nil {
+				return err.ErrorMsg{Err: e, Source: RetryErrorSource}
+			}
+
+			return RetriedMsg{Message: *message}
+		},
+	)
+}
+
+// MoveCmd ends the choice of the target queue and moves the message to the target queue.
+func (model *Model) MoveCmd(target string) tea.Cmd {
+	message := model.moving
+	if message == nil {
+		return nil
+	}
+
+	model.moving = nil
+
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Moving message "+message.ID+" to queue "+target+"…"),
+		func() tea.Msg {
+			if e := api.MoveQueueMessage(context.Background(), *message, target); e != nil {
+				return err.ErrorMsg{Err: e, Source: MoveErrorSource}
+			}
+
+			return MovedMsg{Message: *message, Target: target}
+		},
+	)
+}
+
+// DeleteCmd deletes the selected message from its queue.
+func (model *Model) DeleteCmd() tea.Cmd {
+	message := model.SelectedMessage()
+	if message == nil {
+		return nil
+	}
+
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Deleting message "+message.ID+"…"),
+		func() tea.Msg {
+			if e := api.DeleteQueueMessage(context.Background(), *message); e != nil {
+				return err.ErrorMsg{Err: e, Source: DeleteErrorSource}
+			}
+
+			return DeletedMsg{Message: *message}
+		},
+	)
+}
+
+func (model *Model) activeList() *list.Model {
+	if model.queue != nil && model.moving == nil {
+		return &model.messages
+	}
+
+	return &model.queues
+}
+
+// SelectedQueue returns the queue that is selected in the list of queues, if any.
+func (model *Model) SelectedQueue() *api.Queue {
+	selectedItem := model.queues.SelectedItem()
+	if selectedItem == nil {
+		return nil
+	}
+
+	queue := api.Queue(selectedItem.(QueueItem))
+
+	return &queue
+}
+
+// SelectedMessage returns the message that is selected in the opened queue, if any.
+func (model *Model) SelectedMessage() *api.QueueMessage {
+	if model.queue == nil || model.moving != nil {
+		return nil
+	}
+
+	selectedItem := model.messages.SelectedItem()
+	if selectedItem == nil {
+		return nil
+	}
+
+	message := api.QueueMessage(selectedItem.(MessageItem))
+
+	return &message
+}
+
+// removeMessage removes the message from the opened queue, if it is listed there, and from the count of messages
+// of its queue.
+func (model *Model) removeMessage(message api.QueueMessage) {
+	if idx := slices.IndexFunc(model.messages.Items(), func(item list.Item) bool {
+		return item.(MessageItem).ID == message.ID
+	}); idx != -1 && model.queue != nil && model.queue.Name == message.QueueName {
+		model.messages.RemoveItem(idx)
+	}
+
+	model.updateQueue(message.QueueName, func(item *QueueItem) {
+		item.NumberOfMessages = max(0, item.NumberOfMessages-1)
+	})
+}
+
+// updateQueue applies the change to the queue in the list of queues, if it is there.
+func (model *Model) updateQueue(name string, change func(item *QueueItem)) {
+	for idx, listItem := range model.queues.Items() {
+		item := listItem.(QueueItem)
+		if item.Name == name {
+			change(&item)
+			model.queues.SetItem(idx, item)
+
+			return
+		}
+	}
+}
+
+// describeQueue summarizes how full the queue is.
+func describeQueue(queue api.Queue) string {
+	var text string
+
+	if queue.Usage() < 0 {
+		text = fmt.Sprintf("Queue %s: %d messages, %s", queue.Name, queue.NumberOfMessages,
+			format.Size(queue.Size))
+	} else {
+		text = fmt.Sprintf("Queue %s: %d messages, %s of %s (%d%%)", queue.Name, queue.NumberOfMessages,
+			format.Size(queue.Size), format.Size(queue.MaxQueueSize), queue.Usage())
+	}
+
+	switch {
+	case queue.Overflow:
+		text += ". The queue has overflowed and doesn't accept new messages"
+	case isNearlyFull(queue):
+		text += fmt.Sprintf(". Usage has reached %d%%, the queue overflows once it is full",
+			config.UIQueuesUsageWarningPercent())
+	}
+
+	return text
+}
+
+// sortedQueues orders queues by name.
+func sortedQueues(queues []api.Queue) []api.Queue {
+	queues = slices.Clone(queues)
+
+	slices.SortStableFunc(queues, func(a, b api.Queue) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	return queues
+}
+
+// sortedMessages orders messages by creation time, oldest first, which is the order they are delivered in.
+func sortedMessages(messages []api.QueueMessage) []api.QueueMessage {
+	messages = slices.Clone(messages)
+
+	slices.SortStableFunc(messages, func(a, b api.QueueMessage) int {
+		return a.CreatedAt.Compare(b.CreatedAt.Time)
+	})
+
+	return messages
+}
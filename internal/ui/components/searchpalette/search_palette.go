@@ -0,0 +1,529 @@
+package searchpalette
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
+	"github.com/sahilm/fuzzy"
+	"github.com/vadimklimov/cpi-navigator/internal/config"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/filter"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/pane"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
+)
+
+// Model is a palette for searching the tenant-wide index of content packages and integration artifacts.
+// The index is built in the background when the palette is opened for the first time.
+type Model struct {
+	common   common.Common
+	input    textinput.Model
+	loader   pane.Loader
+	entries  []Entry
+	results  []result
+	selected int
+	offset   int
+	width    int
+	height   int
+	state    indexState
+	queue    []string
+	inFlight int
+	indexed  int
+	failed   int
+	total    int
+}
+
+// Entry is an indexed content package or integration artifact. ArtifactType is empty for content packages.
+type Entry struct {
+	PackageID    string
+	PackageName  string
+	ArtifactType string
+	ArtifactID   string
+	ArtifactName string
+}
+
+type result struct {
+	entry   Entry
+	matches []int
+}
+
+type indexState int
+
+const (
+	notIndexed indexState = iota
+	indexing
+	indexed
+)
+
+// ErrorSource identifies errors of commands issued by the search palette.
+const ErrorSource = "search"
+
+type (
+	IndexPackagesMsg struct {
+		Packages []api.ContentPackage
+		ctx      context.Context
+	}
+	IndexArtifactsMsg struct {
+		PackageID string
+		Entries   []Entry
+		Err       error
+		ctx       context.Context
+	}
+	SelectMsg Entry
+	CloseMsg  struct{}
+)
+
+var supportedArtifactTypes = api.SupportedArtifactTypes()
+
+func New() *Model {
+	common := common.New()
+
+	input := textinput.New()
+	input.Prompt = "> "
+	input.Placeholder = "Package or artifact name or ID"
+	input.PromptStyle = common.Styles.SearchPalette.Input
+	input.TextStyle = common.Styles.SearchPalette.Item.Normal
+	input.PlaceholderStyle = common.Styles.SearchPalette.NoItems
+
+	return &Model{
+		common:  common,
+		input:   input,
+		loader:  pane.NewLoader(ErrorSource, common.Styles.SearchPalette.Status),
+		entries: make([]Entry, 0),
+		results: make([]result, 0),
+	}
+}
+
+func (*Model) Init() tea.Cmd {
+	return nil
+}
+
+func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var (
+		cmd  tea.Cmd
+		cmds = make([]tea.Cmd, 0)
+	)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, model.common.KeyMap.Close):
+			cmds = append(cmds, closeCmd)
+
+		case key.Matches(msg, model.common.KeyMap.Up):
+			if len(model.results) > 0 {
+				model.selected = (model.selected - 1 + len(model.results)) % len(model.results)
+			}
+
+		case key.Matches(msg, model.common.KeyMap.Down):
+			if len(model.results) > 0 {
+				model.selected = (model.selected + 1) % len(model.results)
+			}
+
+		case key.Matches(msg, model.common.KeyMap.Enter):
+			if len(model.results) > 0 {
+				entry := model.results[model.selected].entry
+				cmds = append(cmds, func() tea.Msg { return SelectMsg(entry) })
+			}
+
+		default:
+			query := model.input.Value()
+			model.input, cmd = model.input.Update(msg)
+			cmds = append(cmds, cmd)
+
+			if model.input.Value() != query {
+				model.search()
+			}
+		}
+
+	case spinner.TickMsg:
+		cmds = append(cmds, model.loader.Update(msg))
+
+	case err.ErrorMsg:
+		model.loader.Update(msg)
+
+		if msg.Source == ErrorSource {
+			model.state = notIndexed
+		}
+
+	case err.RetryMsg:
+		cmds = append(cmds, model.loader.Update(msg))
+
+		// Indexing starts over, unless the index has been reset since it failed.
+		if msg.Source == ErrorSource && model.loader.Loading() {
+			model.startIndexing()
+		}
+
+	case IndexPackagesMsg:
+		// The index has been reset since the packages were requested.
+		if !model.loader.Current(msg.ctx) {
+			break
+		}
+
+		model.total = len(msg.Packages)
+		model.queue = make([]string, 0, len(msg.Packages))
+
+		for _, pkg := range msg.Packages {
+			model.entries = append(model.entries, Entry{PackageID: pkg.ID, PackageName: pkg.Name})
+			model.queue = append(model.queue, pkg.ID)
+		}
+
+		model.search()
+
+		cmds = append(cmds, model.indexNextCmds()...)
+		if model.total == 0 && model.loader.Done(msg.ctx) {
+			cmds = append(cmds, model.finishIndexing())
+		}
+
+	case IndexArtifactsMsg:
+		if !model.loader.Current(msg.ctx) {
+			break
+		}
+
+		model.inFlight--
+		model.indexed++
+
+		if msg.Err != nil {
+			model.failed++
+			err.Log(msg.Err)
+		}
+
+		model.entries = append(model.entries, msg.Entries...)
+		model.search()
+
+		cmds = append(cmds, model.indexNextCmds()...)
+		if model.indexed == model.total && model.loader.Done(msg.ctx) {
+			cmds = append(cmds, model.finishIndexing())
+		}
+
+	default:
+		model.input, cmd = model.input.Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	return model, tea.Batch(cmds...)
+}
+
+// SetSize sets the size of the palette, including its border.
+func (model *Model) SetSize(width, height int) {
+	const (
+		maxWidth  = 100
+		maxHeight = 24
+	)
+
+	model.width = min(maxWidth, width)
+	model.height = min(maxHeight, height)
+
+	model.input.Width = max(1, model.contentWidth()-lipgloss.Width(model.input.Prompt)-1)
+	model.scroll()
+}
+
+// Open resets the query and starts building the index, unless it has been built already.
+func (model *Model) Open() tea.Cmd {
+	model.input.Reset()
+	model.selected = 0
+	model.search()
+
+	cmds := []tea.Cmd{model.input.Focus()}
+
+	if model.state == notIndexed {
+		cmds = append(cmds, model.IndexCmd())
+	}
+
+	return tea.Batch(cmds...)
+}
+
+// Reset discards the index, so that it is rebuilt when the palette is opened next time.
+func (model *Model) Reset() {
+	model.loader.Reset()
+	model.state = notIndexed
+	model.entries = make([]Entry, 0)
+	model.results = make([]result, 0)
+}
+
+// IndexCmd builds the index of content packages and integration artifacts of all supported types.
+// Artifacts of a limited number of content packages are requested concurrently.
+func (model *Model) IndexCmd() tea.Cmd {
+	model.Reset()
+	model.startIndexing()
+
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Indexing content packages and integration artifacts…"),
+		model.loader.Load(indexPackages),
+	)
+}
+
+// startIndexing clears the progress of indexing, which starts with content packages.
+func (model *Model) startIndexing() {
+	model.state = indexing
+	model.queue = nil
+	model.inFlight = 0
+	model.indexed = 0
+	model.failed = 0
+	model.total = 0
+}
+
+func (model *Model) finishIndexing() tea.Cmd {
+	model.state = indexed
+
+	message := fmt.Sprintf("Indexed %d content packages and %d integration artifacts in %d ms",
+		model.total, len(model.entries)-model.total, model.loader.Elapsed().Milliseconds())
+
+	if model.failed > 0 {
+		message += fmt.Sprintf(" (%d content packages failed, see log)", model.failed)
+	}
+
+	return statusbar.StatusMessageCmd(message)
+}
+
+func indexPackages(ctx context.Context) (tea.Msg, error) {
+	packages, e := api.ContentPackages(ctx)
+	if e != nil {
+		return nil, e
+	}
+
+	return IndexPackagesMsg{Packages: packages, ctx: ctx}, nil
+}
+
+// indexNextCmds takes content packages off the queue for as long as the concurrency limit allows.
+func (model *Model) indexNextCmds() []tea.Cmd {
+	cmds := make([]tea.Cmd, 0)
+
+	for len(model.queue) > 0 && model.inFlight < config.UISearchConcurrency() {
+		packageID := model.queue[0]
+		model.queue = model.queue[1:]
+		model.inFlight++
+
+		packageName := ""
+		if idx := slices.IndexFunc(model.entries, func(entry Entry) bool {
+			return entry.ArtifactType == "" && entry.PackageID == packageID
+		}); idx != -1 {
+			packageName = model.entries[idx].PackageName
+		}
+
+		cmds = append(cmds, model.loader.Continue(indexArtifacts(packageID, packageName)))
+	}
+
+	return cmds
+}
+
+// indexArtifacts fetches artifacts of all supported types in the content package. A failure doesn't fail
+// the whole index, it is reported with the entries of the package that have been fetched before.
+func indexArtifacts(packageID, packageName string) func(ctx context.Context) (tea.Msg, error) {
+	return func(ctx context.Context) (tea.Msg, error) {
+		entries := make([]Entry, 0)
+
+		for _, artifactType := range artifactTypes() {
+			artifacts, e := api.IntegrationArtifactsByPackageAndType(ctx, packageID, artifactType)
+			if e != nil {
+				return IndexArtifactsMsg{PackageID: packageID, Entries: entries, Err: e, ctx: ctx}, nil
+			}
+
+			for _, artifact := range artifacts {
+				entries = append(entries, Entry{
+					PackageID:    packageID,
+					PackageName:  packageName,
+					ArtifactType: artifactType,
+					ArtifactID:   artifact.ID,
+					ArtifactName: artifact.Name,
+				})
+			}
+		}
+
+		return IndexArtifactsMsg{PackageID: packageID, Entries: entries, ctx: ctx}, nil
+	}
+}
+
+// search fuzzy-matches indexed entries against the query, keeping the best matches first.
+func (model *Model) search() {
+	query := strings.TrimSpace(model.input.Value())
+	model.results = model.results[:0]
+
+	if query == "" {
+		for _, entry := range model.entries {
+			model.results = append(model.results, result{entry: entry})
+		}
+	} else {
+		values := make([]string, 0, len(model.entries))
+		for _, entry := range model.entries {
+			values = append(values, filter.Value(entry.name(), entry.id()))
+		}
+
+		for _, match := range fuzzy.Find(query, values) {
+			model.results = append(model.results, result{
+				entry:   model.entries[match.Index],
+				matches: match.MatchedIndexes,
+			})
+		}
+	}
+
+	model.selected = min(model.selected, max(0, len(model.results)-1))
+	if query != "" {
+		model.selected = 0
+	}
+
+	model.scroll()
+}
+
+// scroll keeps the selected result within the visible results.
+func (model *Model) scroll() {
+	visible := model.visibleResults()
+
+	if model.selected < model.offset {
+		model.offset = model.selected
+	}
+
+	if model.selected >= model.offset+visible {
+		model.offset = model.selected - visible + 1
+	}
+
+	model.offset = max(0, min(model.offset, len(model.results)-visible))
+}
+
+func (model *Model) View() string {
+	styles := model.common.Styles.SearchPalette
+	width := model.contentWidth()
+
+	lines := []string{
+		styles.Title.Render("Search"),
+		model.input.View(),
+		styles.NoItems.Render(strings.Repeat("─", width)),
+	}
+
+	model.scroll()
+	visible := model.visibleResults()
+
+	switch {
+	case len(model.results) == 0 && model.state == indexing:
+		lines = append(lines, styles.NoItems.Render("Waiting for the index…"))
+	case len(model.results) == 0:
+		lines = append(lines, styles.NoItems.Render("No results"))
+	}
+
+	for idx := model.offset; idx < min(len(model.results), model.offset+visible); idx++ {
+		lines = append(lines, model.resultView(model.results[idx], idx == model.selected, width))
+	}
+
+	for len(lines) < visible+3 {
+		lines = append(lines, "")
+	}
+
+	lines = append(lines, model.statusView(width))
+
+	return styles.Area.Width(model.width - styles.Area.GetHorizontalBorderSize()).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+func (model *Model) resultView(result result, selected bool, width int) string {
+	styles := model.common.Styles.SearchPalette
+
+	style := styles.Item.Normal
+	typeStyle := styles.Item.Type.Inherit(style)
+	packageStyle := styles.Item.Package.Inherit(style)
+
+	if selected {
+		style = styles.Item.Selected
+		typeStyle = style.Width(styles.Item.Type.GetWidth())
+		packageStyle = style
+	}
+
+	badge := typeStyle.Render(result.entry.badge())
+
+	packageName := ""
+	if result.entry.ArtifactType != "" {
+		packageName = truncate.StringWithTail(result.entry.PackageName, uint(max(0, width/3)), "…")
+	}
+
+	nameWidth := max(0, width-lipgloss.Width(badge)-lipgloss.Width(packageName)-1)
+	name := filter.Render(result.entry.name(), nameWidth, result.matches, style.Width(nameWidth+1), styles.Item.Match)
+
+	return lipgloss.JoinHorizontal(lipgloss.Top, badge, name, packageStyle.Render(packageName))
+}
+
+func (model *Model) statusView(width int) string {
+	styles := model.common.Styles.SearchPalette
+
+	var status string
+
+	switch {
+	case model.loader.Err() != nil:
+		status = "Indexing failed: " + err.Summary(model.loader.Err())
+	case model.state == indexing && model.total == 0:
+		status = model.loader.Spinner() + " Indexing content packages…"
+	case model.state == indexing:
+		status = fmt.Sprintf("%s Indexing… %d/%d content packages", model.loader.Spinner(), model.indexed, model.total)
+	default:
+		status = fmt.Sprintf("%d content packages, %d integration artifacts", model.total, len(model.entries)-model.total)
+	}
+
+	if len(model.results) > 0 {
+		status = fmt.Sprintf("%d/%d · %s", model.selected+1, len(model.results), status)
+	}
+
+	return styles.Status.Render(truncate.StringWithTail(status, uint(max(0, width)), "…"))
+}
+
+func (model *Model) contentWidth() int {
+	return max(1, model.width-model.common.Styles.SearchPalette.Area.GetHorizontalFrameSize())
+}
+
+// visibleResults returns the number of results that fit in the palette
+// below the title, the input and the separator, and above the status line.
+func (model *Model) visibleResults() int {
+	const reservedLines = 4
+
+	return max(1, model.height-model.common.Styles.SearchPalette.Area.GetVerticalFrameSize()-reservedLines)
+}
+
+func (entry Entry) name() string {
+	if entry.ArtifactType == "" {
+		return entry.PackageName
+	}
+
+	return entry.ArtifactName
+}
+
+func (entry Entry) id() string {
+	if entry.ArtifactType == "" {
+		return entry.PackageID
+	}
+
+	return entry.ArtifactID
+}
+
+func (entry Entry) badge() string {
+	switch entry.ArtifactType {
+	case supportedArtifactTypes.Designtime.IntegrationFlow.Name:
+		return "IF"
+	case supportedArtifactTypes.Designtime.ValueMapping.Name:
+		return "VM"
+	case supportedArtifactTypes.Designtime.MessageMapping.Name:
+		return "MM"
+	case supportedArtifactTypes.Designtime.ScriptCollection.Name:
+		return "SC"
+	default:
+		return "PKG"
+	}
+}
+
+func artifactTypes() []string {
+	return []string{
+		supportedArtifactTypes.Designtime.IntegrationFlow.Name,
+		supportedArtifactTypes.Designtime.ValueMapping.Name,
+		supportedArtifactTypes.Designtime.MessageMapping.Name,
+		supportedArtifactTypes.Designtime.ScriptCollection.Name,
+	}
+}
+
+func closeCmd() tea.Msg {
+	return CloseMsg{}
+}
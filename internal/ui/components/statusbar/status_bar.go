@@ -1,25 +1,59 @@
 package statusbar
 
 import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/muesli/reflow/truncate"
 	"github.com/vadimklimov/cpi-navigator/internal/config"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/format"
 )
 
 type Model struct {
 	common          common.Common
 	tenant, message string
+	notifications   []notification
+	refreshes       map[string]time.Time
+	pane            string
+	progress        *Progress
+}
+
+type notification struct {
+	message   string
+	retryable bool
 }
 
-type StatusMsg string
+type (
+	StatusMsg    string
+	RefreshedMsg struct {
+		Pane string
+		At   time.Time
+	}
+)
+
+// Progress of a long-running transfer. Total is -1 if the size of the transfer is unknown.
+type Progress struct {
+	Label   string
+	Current int64
+	Total   int64
+}
+
+const progressBarWidth = 20
 
 func New() *Model {
 	return &Model{
-		common:  common.New(),
-		tenant:  config.TenantName(),
-		message: config.TenantWebUIURL().String(),
+		common:        common.New(),
+		tenant:        config.TenantName(),
+		message:       config.TenantWebUIURL().String(),
+		notifications: make([]notification, 0),
+		refreshes:     make(map[string]time.Time),
 	}
 }
 
@@ -29,29 +63,144 @@ func (*Model) Init() tea.Cmd {
 
 func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, model.common.KeyMap.Dismiss):
+			if len(model.notifications) > 0 {
+				model.notifications = model.notifications[:len(model.notifications)-1]
+			}
+
+		// Retried failures are reported again if they persist.
+		case key.Matches(msg, model.common.KeyMap.Retry):
+			model.notifications = slices.DeleteFunc(model.notifications, func(n notification) bool {
+				return n.retryable
+			})
+		}
+
 	case StatusMsg:
 		model.message = string(msg)
+
+	case RefreshedMsg:
+		model.refreshes[msg.Pane] = msg.At
+
+	case err.ErrorMsg:
+		model.notifications = append(model.notifications, notification{
+			message:   err.Summary(msg.Err),
+			retryable: msg.Retry != nil,
+		})
 	}
 
 	return model, nil
 }
 
+// SetProgress displays progress of the transfer instead of the status message. Nil progress removes it.
+func (model *Model) SetProgress(progress *Progress) {
+	model.progress = progress
+}
+
+// SetPane sets the pane whose last refresh is displayed, i.e. the active pane of the workspace
+// or the pane of the open screen.
+func (model *Model) SetPane(pane string) {
+	model.pane = pane
+}
+
+// SetWidth sets the width of the status bar.
+func (model *Model) SetWidth(width int) {
+	model.common.Styles.StatusBar.Area = model.common.Styles.StatusBar.Area.Width(width)
+}
+
 func (model *Model) View() string {
+	style := model.common.Styles.StatusBar.Message
+	message := model.message
+
+	if model.progress != nil {
+		message = model.progressView()
+	}
+
+	// The most recent notification takes precedence over the status message until it is dismissed.
+	if len(model.notifications) > 0 {
+		latest := model.notifications[len(model.notifications)-1]
+		keys := []key.Binding{model.common.KeyMap.Dismiss}
+
+		if latest.retryable {
+			keys = append(keys, model.common.KeyMap.Retry)
+		}
+
+		hints := make([]string, 0, len(keys))
+		for _, binding := range keys {
+			hints = append(hints, binding.Help().Key+": "+binding.Help().Desc)
+		}
+
+		style = model.common.Styles.StatusBar.Notification
+		message = fmt.Sprintf("%s (%s)", latest.message, strings.Join(hints, ", "))
+
+		if len(model.notifications) > 1 {
+			message = fmt.Sprintf("[%d] %s", len(model.notifications), message)
+		}
+	}
+
+	refreshed := model.refreshedView()
+
 	width := model.common.Styles.StatusBar.Area.GetWidth() -
 		model.common.Styles.StatusBar.Area.GetHorizontalFrameSize() -
 		model.common.Styles.StatusBar.Tenant.GetHorizontalFrameSize() -
-		model.common.Styles.StatusBar.Message.GetHorizontalFrameSize() -
-		len(model.tenant)
-	message := truncate.StringWithTail(model.message, uint(width), "…")
+		style.GetHorizontalFrameSize() -
+		lipgloss.Width(model.tenant) -
+		lipgloss.Width(refreshed)
+	message = truncate.StringWithTail(message, uint(max(width, 0)), "…")
 
 	return lipgloss.JoinHorizontal(
 		lipgloss.Top,
 		model.common.Styles.StatusBar.Tenant.Render(model.tenant),
-		model.common.Styles.StatusBar.Message.Render(message),
+		style.Width(max(width, 0)+style.GetHorizontalFrameSize()).Render(message),
+		refreshed,
+	)
+}
+
+// refreshedView renders when the pane was last refreshed. Refreshes of other panes aren't displayed,
+// so that the status bar fits a single line.
+func (model *Model) refreshedView() string {
+	at, ok := model.refreshes[model.pane]
+	if !ok {
+		return ""
+	}
+
+	return model.common.Styles.StatusBar.Refreshed.Render(
+		fmt.Sprintf("Refreshed %s: %s", model.pane, at.Format(time.TimeOnly)))
+}
+
+// progressView renders the label of the transfer followed by a progress bar, or by the transferred size
+// if the total size is unknown.
+func (model *Model) progressView() string {
+	styles := model.common.Styles.StatusBar.Progress
+	progress := model.progress
+
+	if progress.Total <= 0 {
+		return fmt.Sprintf("%s %s", progress.Label, format.Size(progress.Current))
+	}
+
+	const percent = 100
+
+	ratio := min(1, float64(progress.Current)/float64(progress.Total))
+	done := int(ratio * progressBarWidth)
+
+	return fmt.Sprintf("%s %s%s %3.0f%% %s / %s",
+		progress.Label,
+		styles.Done.Render(strings.Repeat("█", done)),
+		styles.Pending.Render(strings.Repeat("░", progressBarWidth-done)),
+		ratio*percent,
+		format.Size(progress.Current),
+		format.Size(progress.Total),
 	)
 }
 
-func (*Model) StatusMessageCmd(message string) tea.Cmd {
+func RefreshedCmd(pane string, at time.Time) tea.Cmd {
+	return func() tea.Msg {
+		return RefreshedMsg{Pane: pane, At: at}
+	}
+}
+
+func StatusMessageCmd(message string) tea.Cmd {
 	return func() tea.Msg {
 		return StatusMsg(message)
 	}
@@ -0,0 +1,383 @@
+package upload
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/format"
+	"github.com/vadimklimov/cpi-navigator/internal/util"
+)
+
+// Model is a form for the zip archive to update content of an integration artifact with,
+// or to create a new integration artifact in a content package from. Only one upload runs at a time. While it runs,
+// the form offers to cancel it instead.
+type Model struct {
+	common   common.Common
+	inputs   []textinput.Model
+	focused  int
+	target   Target
+	transfer *transfer
+	err      error
+	width    int
+}
+
+// Target is the integration artifact to be updated. When Create is set, a new artifact of the type
+// is created in the content package instead, and ID and Name are entered in the form.
+type Target struct {
+	ArtifactType string
+	PackageID    string
+	ID           string
+	Name         string
+	Create       bool
+}
+
+// Request is the upload submitted with the form.
+type Request struct {
+	Target
+	Path    string
+	Content []byte
+}
+
+// transfer is an upload in progress. The upload is aborted when its context is cancelled.
+type transfer struct {
+	request Request
+	ctx     context.Context
+	cancel  context.CancelFunc
+}
+
+type (
+	// SubmitMsg carries the upload to be confirmed.
+	SubmitMsg Request
+	// StartMsg starts the confirmed upload.
+	StartMsg Request
+	CloseMsg struct{}
+	// UploadedMsg reports the uploaded artifact.
+	UploadedMsg struct {
+		Request
+		transfer *transfer
+	}
+	// CancelledMsg reports that the upload has been cancelled. The tenant may have applied it nevertheless,
+	// if the archive had been sent by then.
+	CancelledMsg struct {
+		Request
+		transfer *transfer
+	}
+)
+
+// ErrorSource identifies errors of uploads.
+const ErrorSource = "upload"
+
+const (
+	fileField = iota
+	idField
+	nameField
+)
+
+const labelWidth = 10
+
+var labels = []string{
+	fileField: "Zip file",
+	idField:   "ID",
+	nameField: "Name",
+}
+
+func New() *Model {
+	common := common.New()
+	styles := common.Styles.UploadDialog
+
+	placeholders := []string{
+		fileField: "path to the zip archive",
+		idField:   "file name without extension",
+		nameField: "artifact ID",
+	}
+
+	inputs := make([]textinput.Model, 0, len(labels))
+
+	for _, placeholder := range placeholders {
+		input := textinput.New()
+		input.Prompt = ""
+		input.Placeholder = placeholder
+		input.TextStyle = styles.Input
+		input.PlaceholderStyle = styles.Placeholder
+		inputs = append(inputs, input)
+	}
+
+	return &Model{
+		common: common,
+		inputs: inputs,
+	}
+}
+
+func (*Model) Init() tea.Cmd {
+	return nil
+}
+
+func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var (
+		cmd  tea.Cmd
+		cmds = make([]tea.Cmd, 0)
+	)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, model.common.KeyMap.Close):
+			cmds = append(cmds, closeCmd)
+
+		case model.transfer != nil:
+			// While the upload is in progress, the form only asks whether to cancel it.
+			if key.Matches(msg, model.common.KeyMap.Enter) {
+				model.transfer.cancel()
+				cmds = append(cmds, closeCmd)
+			}
+
+		case key.Matches(msg, model.common.KeyMap.Up):
+			cmds = append(cmds, model.focus((model.focused-1+model.fields())%model.fields()))
+
+		case key.Matches(msg, model.common.KeyMap.Down), key.Matches(msg, model.common.KeyMap.Tab):
+			cmds = append(cmds, model.focus((model.focused+1)%model.fields()))
+
+		case key.Matches(msg, model.common.KeyMap.Enter):
+			request, e := model.request()
+			if e != nil {
+				model.err = e
+
+				break
+			}
+
+			model.err = nil
+			cmds = append(cmds, func() tea.Msg { return SubmitMsg(request) })
+
+		default:
+			model.inputs[model.focused], cmd = model.inputs[model.focused].Update(msg)
+			cmds = append(cmds, cmd)
+		}
+
+	case StartMsg:
+		model.transfer = &transfer{request: Request(msg)}
+		model.transfer.ctx, model.transfer.cancel = context.WithCancel(context.Background())
+		cmds = append(cmds, uploadCmd(model.transfer))
+
+	case UploadedMsg:
+		if msg.transfer == model.transfer {
+			model.transfer = nil
+		}
+
+	case CancelledMsg:
+		if msg.transfer == model.transfer {
+			model.transfer = nil
+		}
+
+	case err.ErrorMsg:
+		if msg.Source == ErrorSource {
+			model.transfer = nil
+		}
+
+	default:
+		model.inputs[model.focused], cmd = model.inputs[model.focused].Update(msg)
+		cmds = append(cmds, cmd)
+	}
+
+	return model, tea.Batch(cmds...)
+}
+
+// SetSize sets the size of the form, including its border.
+func (model *Model) SetSize(width, _ int) {
+	const maxWidth = 80
+
+	model.width = min(maxWidth, width)
+
+	for idx := range model.inputs {
+		model.inputs[idx].Width = max(1, model.contentWidth()-labelWidth-1)
+	}
+}
+
+// Open asks for the zip archive to upload to the target. The path of the previous upload is kept,
+// while ID and name of a new artifact are cleared. While an upload is in progress, the form asks whether
+// to cancel it instead.
+func (model *Model) Open(target Target) tea.Cmd {
+	if model.transfer != nil {
+		model.err = nil
+
+		return nil
+	}
+
+	model.target = target
+	model.err = nil
+
+	model.inputs[idField].Reset()
+	model.inputs[nameField].Reset()
+
+	return model.focus(fileField)
+}
+
+func (model *Model) View() string {
+	styles := model.common.Styles.UploadDialog
+	width := model.contentWidth()
+
+	if model.transfer != nil {
+		return styles.Area.Width(model.width - styles.Area.GetHorizontalBorderSize()).Render(
+			lipgloss.JoinVertical(lipgloss.Left,
+				styles.Title.Render(ansi.Truncate("Uploading "+model.transfer.request.Name, width, "…")),
+				"",
+				styles.Hint.Render(ansi.Truncate(fmt.Sprintf("%s cancel upload · %s keep uploading",
+					model.common.KeyMap.Enter.Help().Key, model.common.KeyMap.Close.Help().Key), width, "…")),
+			),
+		)
+	}
+
+	title := fmt.Sprintf("Upload new content of %s", model.target.Name)
+	if model.target.Create {
+		title = fmt.Sprintf("Create %s in content package %s", model.target.ArtifactType, model.target.PackageID)
+	}
+
+	lines := []string{styles.Title.Render(ansi.Truncate(title, width, "…")), ""}
+
+	for idx := range model.fields() {
+		labelStyle := styles.Label
+		if idx == model.focused {
+			labelStyle = styles.Focused
+		}
+
+		lines = append(lines, labelStyle.Width(labelWidth).Render(labels[idx])+" "+model.inputs[idx].View())
+	}
+
+	status := styles.Hint.Render(ansi.Truncate(fmt.Sprintf("%s next field · %s upload · %s cancel",
+		model.common.KeyMap.Tab.Help().Key, model.common.KeyMap.Enter.Help().Key,
+		model.common.KeyMap.Close.Help().Key), width, "…"))
+	if model.err != nil {
+		status = styles.Error.Render(ansi.Truncate(model.err.Error(), width, "…"))
+	}
+
+	lines = append(lines, "", status)
+
+	return styles.Area.Width(model.width - styles.Area.GetHorizontalBorderSize()).
+		Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
+
+// Question returns the question to confirm the upload with.
+func Question(request Request) string {
+	if request.Create {
+		return fmt.Sprintf("Create %s %s (%s) in content package %s from %s (%s)?",
+			request.ArtifactType, request.ID, request.Name, request.PackageID,
+			request.Path, format.Size(int64(len(request.Content))))
+	}
+
+	return fmt.Sprintf("Replace content of %s with %s (%s)? The current content is overwritten.",
+		request.Name, request.Path, format.Size(int64(len(request.Content))))
+}
+
+// Uploading reports whether an upload is in progress.
+func (model *Model) Uploading() bool {
+	return model.transfer != nil
+}
+
+// UploadCmd starts the confirmed upload. A failed upload isn't run again with the retry key, as it has to be
+// confirmed: the upload form is opened again instead.
+func UploadCmd(request Request) tea.Cmd {
+	return func() tea.Msg {
+		return StartMsg(request)
+	}
+}
+
+// uploadCmd creates the artifact or updates its content with the archive.
+func uploadCmd(transfer *transfer) tea.Cmd {
+	request := transfer.request
+
+	return func() tea.Msg {
+		defer transfer.cancel()
+
+		var e error
+
+		if request.Create {
+			e = api.CreateArtifact(transfer.ctx, request.ArtifactType, request.PackageID,
+				request.ID, request.Name, request.Content)
+		} else {
+			e = api.UpdateArtifact(transfer.ctx, request.ArtifactType, request.ID,
+				request.Name, request.Content)
+		}
+
+		if e != nil {
+			if transfer.ctx.Err() != nil {
+				return CancelledMsg{Request: request, transfer: transfer}
+			}
+
+			return err.ErrorMsg{Err: fmt.Errorf("error uploading %s: %w", request.Name, e), Source: ErrorSource}
+		}
+
+		return UploadedMsg{Request: request, transfer: transfer}
+	}
+}
+
+// fields returns the number of fields in the form. ID and name are only entered for a new artifact.
+func (model *Model) fields() int {
+	if model.target.Create {
+		return len(model.inputs)
+	}
+
+	return fileField + 1
+}
+
+func (model *Model) focus(idx int) tea.Cmd {
+	model.inputs[model.focused].Blur()
+	model.focused = idx
+
+	return model.inputs[idx].Focus()
+}
+
+// request validates the form and reads the archive to be uploaded.
+func (model *Model) request() (Request, error) {
+	value := func(field int) string {
+		return strings.TrimSpace(model.inputs[field].Value())
+	}
+
+	path := value(fileField)
+	if path == "" {
+		return Request{}, errors.New("enter the path to a zip file")
+	}
+
+	if homeDir, e := os.UserHomeDir(); e == nil && (path == "~" || strings.HasPrefix(path, "~/")) {
+		path = filepath.Join(homeDir, strings.TrimPrefix(path, "~"))
+	}
+
+	content, e := util.ReadArchive(path)
+	if e != nil {
+		return Request{}, e
+	}
+
+	request := Request{Target: model.target, Path: path, Content: content}
+
+	if request.Create {
+		request.ID = value(idField)
+		if request.ID == "" {
+			request.ID = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+		}
+
+		request.Name = value(nameField)
+		if request.Name == "" {
+			request.Name = request.ID
+		}
+	}
+
+	return request, nil
+}
+
+func (model *Model) contentWidth() int {
+	return max(1, model.width-model.common.Styles.UploadDialog.Area.GetHorizontalFrameSize())
+}
+
+func closeCmd() tea.Msg {
+	return CloseMsg{}
+}
@@ -0,0 +1,134 @@
+package variable
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/format"
+)
+
+// Item is a variable with its value, which is nil until it has been fetched.
+type Item struct {
+	api.Variable
+
+	Value *Value
+}
+
+// Value is the content of a variable, or the reason it couldn't be downloaded.
+type Value struct {
+	Content []byte
+	Err     error
+}
+
+type ItemDelegate struct {
+	common common.Common
+}
+
+const (
+	visibilityColumnWidth = 16
+	dateColumnWidth       = 16
+	columnGap             = 1
+	dateTimeLayout        = "2006-01-02 15:04"
+)
+
+func (item Item) FilterValue() string {
+	return item.VariableName
+}
+
+func NewVariableItemDelegate() ItemDelegate {
+	return ItemDelegate{
+		common: common.New(),
+	}
+}
+
+func (ItemDelegate) Height() int {
+	return 1
+}
+
+func (ItemDelegate) Spacing() int {
+	return 0
+}
+
+func (ItemDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd {
+	return nil
+}
+
+func (itemDelegate ItemDelegate) Render(writer io.Writer, model list.Model, index int, listItem list.Item) {
+	item := listItem.(Item)
+	styles := itemDelegate.common.Styles.VariablesPane.Dataset
+
+	style := styles.Item.Normal
+	if index == model.Index() {
+		style = styles.Item.Selected
+	}
+
+	cell := lipgloss.NewStyle().
+		Background(style.GetBackground()).
+		Foreground(style.GetForeground())
+
+	value := ""
+	if item.Value != nil {
+		value = preview(*item.Value)
+	}
+
+	fmt.Fprint(writer, style.Width(model.Width()).MaxWidth(model.Width()).Render(
+		row(model.Width(), cell, item.VariableName, item.IntegrationFlow, item.Visibility,
+			dateTime(item.UpdatedAt), dateTime(item.RetainUntil), value),
+	))
+}
+
+// row lays out the name, integration flow, visibility, update time, retention time and value columns
+// of the variables table within the width.
+func row(width int, style lipgloss.Style, name, flow, visibility, updatedAt, retainUntil, value string) string {
+	const columns = 6
+
+	remaining := max(0, width-visibilityColumnWidth-2*dateColumnWidth-(columns-1)*columnGap)
+	nameWidth := remaining * 3 / 10
+	flowWidth := remaining * 3 / 10
+	valueWidth := remaining - nameWidth - flowWidth
+	gap := style.Render(strings.Repeat(" ", columnGap))
+
+	cell := func(value string, width int) string {
+		return style.Width(width).Render(ansi.Truncate(value, width, "…"))
+	}
+
+	return cell(name, nameWidth) + gap +
+		cell(flow, flowWidth) + gap +
+		cell(visibility, visibilityColumnWidth) + gap +
+		cell(updatedAt, dateColumnWidth) + gap +
+		cell(retainUntil, dateColumnWidth) + gap +
+		cell(value, valueWidth)
+}
+
+// preview describes the value on a single line. Binary values are described by their size.
+func preview(value Value) string {
+	switch {
+	case value.Err != nil:
+		return "not available"
+	case isBinary(value.Content):
+		return "binary, " + format.Size(int64(len(value.Content)))
+	default:
+		return strings.Join(strings.Fields(string(value.Content)), " ")
+	}
+}
+
+func isBinary(content []byte) bool {
+	return !utf8.Valid(content) || bytes.IndexByte(content, 0) != -1
+}
+
+func dateTime(value api.DateTime) string {
+	if value.IsZero() {
+		return ""
+	}
+
+	return value.UTC().Format(dateTimeLayout)
+}
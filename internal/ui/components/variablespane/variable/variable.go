@@ -0,0 +1,357 @@
+package variable
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/format"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/pane"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
+)
+
+// Model lists global and local variables of the runtime, optionally only those of an integration flow.
+// The value of the selected variable is downloaded and displayed below the list.
+type Model struct {
+	common    common.Common
+	variables list.Model
+	// All loaded variables, of which those of the integration flow are listed if the filter is on.
+	all          []Item
+	flowID       string
+	filterByFlow bool
+	loader       pane.Loader
+	valueCancel  context.CancelFunc
+	width        int
+}
+
+// ErrorSource identifies errors of commands that load variables.
+const ErrorSource = "variables"
+
+// Lines that the value of the selected variable takes below the list.
+const valueLines = 3
+
+type (
+	VariablesMsg struct {
+		Variables []api.Variable
+		ctx       context.Context
+	}
+	// ValueMsg holds the value of the variable, or the reason it couldn't be downloaded.
+	ValueMsg struct {
+		Variable api.Variable
+		Value    Value
+	}
+)
+
+func New() *Model {
+	common := common.New()
+	styles := common.Styles.VariablesPane
+
+	variables := list.New(make([]list.Item, 0), NewVariableItemDelegate(), 0, 0)
+	variables.DisableQuitKeybindings()
+	variables.SetShowHelp(false)
+	variables.SetShowTitle(false)
+	variables.SetShowPagination(false)
+	variables.SetShowStatusBar(false)
+	variables.SetFilteringEnabled(false)
+	variables.SetStatusBarItemName("variable", "variables")
+	variables.Styles.NoItems = styles.Dataset.NoItems
+
+	return &Model{
+		common:    common,
+		variables: variables,
+		loader:    pane.NewLoader(ErrorSource, styles.Dataset.Loading),
+	}
+}
+
+func (*Model) Init() tea.Cmd {
+	return nil
+}
+
+func (model *Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var (
+		cmd  tea.Cmd
+		cmds = make([]tea.Cmd, 0)
+	)
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, model.common.KeyMap.Up), key.Matches(msg, model.common.KeyMap.Down):
+			model.variables, cmd = model.variables.Update(msg)
+			cmds = append(cmds, cmd, model.valueCmd())
+
+		case key.Matches(msg, model.common.KeyMap.FlowFilter):
+			if model.flowID != "" {
+				model.filterByFlow = !model.filterByFlow
+				cmd = model.variables.SetItems(model.listedItems())
+				model.variables.ResetSelected()
+				cmds = append(cmds, cmd, model.valueCmd())
+			}
+
+		case key.Matches(msg, model.common.KeyMap.Refresh):
+			cmds = append(cmds, model.VariablesCmd())
+		}
+
+	case spinner.TickMsg, err.ErrorMsg, err.RetryMsg:
+		cmds = append(cmds, model.loader.Update(msg))
+
+	case VariablesMsg:
+		if !model.loader.Done(msg.ctx) {
+			break
+		}
+		model.all = make([]Item, 0, len(msg.Variables))
+
+		for _, variable := range sorted(msg.Variables) {
+			model.all = append(model.all, Item{Variable: variable})
+		}
+
+		cmds = append(cmds,
+			statusbar.StatusMessageCmd(fmt.Sprintf("Loaded %d variables in %d ms",
+				len(msg.Variables), model.loader.Elapsed().Milliseconds())),
+			statusbar.RefreshedCmd("Variables", time.Now()),
+			model.variables.SetItems(model.listedItems()),
+			model.valueCmd(),
+		)
+
+	case ValueMsg:
+		value := msg.Value
+
+		for idx := range model.all {
+			if sameVariable(model.all[idx].Variable, msg.Variable) {
+				model.all[idx].Value = &value
+			}
+		}
+
+		for idx, listItem := range model.variables.Items() {
+			if item := listItem.(Item); sameVariable(item.Variable, msg.Variable) {
+				item.Value = &value
+				cmds = append(cmds, model.variables.SetItem(idx, item))
+			}
+		}
+	}
+
+	return model, tea.Batch(cmds...)
+}
+
+// SetSize resizes the pane, including its border.
+func (model *Model) SetSize(width, height int) {
+	styles := model.common.Styles.VariablesPane
+
+	model.width = max(1, width-styles.Area.GetHorizontalFrameSize())
+	model.common.Styles.VariablesPane.Area = styles.Area.
+		Width(model.width).
+		Height(max(0, height-styles.Area.GetVerticalFrameSize()))
+	model.common.Styles.VariablesPane.Title = styles.Title.Width(model.width)
+
+	// The title, the table header, the value of the selected variable and the footer surround the list.
+	const reservedLines = 5 + valueLines
+
+	pane.FitList(&model.variables, model.width, height-styles.Area.GetVerticalFrameSize(), reservedLines)
+}
+
+func (model *Model) View() string {
+	styles := model.common.Styles.VariablesPane
+
+	title := "Variables"
+	if model.filterByFlow {
+		title += " of " + model.flowID
+	}
+
+	return styles.Area.Render(lipgloss.JoinVertical(lipgloss.Left,
+		styles.Title.Render(ansi.Truncate(title, model.width, "…")),
+		styles.Header.Width(model.width).Render(
+			row(model.width, styles.Header,
+				"Name", "Integration flow", "Visibility", "Updated at", "Retain until", "Value"),
+		),
+		lipgloss.NewStyle().Height(model.variables.Height()).Render(model.loader.View(model.variables, "Loading variables…")),
+		model.valueView(),
+		styles.Footer.Width(model.width).Render(ansi.Truncate(model.footerView(), model.width, "…")),
+	))
+}
+
+// valueView shows the beginning of the value of the selected variable.
+func (model *Model) valueView() string {
+	styles := model.common.Styles.VariablesPane
+	lines := make([]string, 0, valueLines)
+
+	if item := model.selectedItem(); item != nil {
+		switch {
+		case item.Value == nil:
+			lines = append(lines, "Fetching value of "+item.VariableName+"…")
+		case item.Value.Err != nil:
+			lines = append(lines, "Value of "+item.VariableName+" can't be downloaded: "+item.Value.Err.Error())
+		case isBinary(item.Value.Content):
+			lines = append(lines, fmt.Sprintf("Value of %s is binary, %s",
+				item.VariableName, format.Size(int64(len(item.Value.Content)))))
+		default:
+			lines = strings.Split(strings.TrimSpace(string(item.Value.Content)), "\n")
+			if len(lines) > valueLines {
+				lines = append(lines[:valueLines-1], "…")
+			}
+		}
+	}
+
+	for idx, line := range lines {
+		lines[idx] = ansi.Truncate(strings.TrimRight(line, "\r"), model.width, "…")
+	}
+
+	return styles.Detail.Width(model.width).
+		Height(valueLines).
+		Border(lipgloss.NormalBorder(), true, false, false, false).
+		BorderForeground(styles.Area.GetBorderTopForeground()).
+		Render(strings.Join(lines, "\n"))
+}
+
+func (model *Model) footerView() string {
+	keys := model.common.KeyMap
+	count := len(model.variables.Items())
+
+	if model.loader.Loading() && count > 0 {
+		return model.loader.Spinner() + " Loading variables…"
+	}
+
+	footer := fmt.Sprintf("%d variables", count)
+
+	switch {
+	case model.flowID == "":
+	case model.filterByFlow:
+		footer += fmt.Sprintf(" of %s · %s show all variables", model.flowID, keys.FlowFilter.Help().Key)
+	default:
+		footer += fmt.Sprintf(" · %s show variables of %s", keys.FlowFilter.Help().Key, model.flowID)
+	}
+
+	return footer + fmt.Sprintf(" · %s back", keys.Close.Help().Key)
+}
+
+// Open lists variables. If the integration flow is given, only its variables are listed until the filter
+// is toggled.
+func (model *Model) Open(flowID string) tea.Cmd {
+	model.flowID = flowID
+	model.filterByFlow = flowID != ""
+	model.all = nil
+	model.variables.SetItems(nil)
+	model.variables.ResetSelected()
+
+	return model.VariablesCmd()
+}
+
+// VariablesCmd loads variables, cancelling the load that is still in progress, if any.
+func (model *Model) VariablesCmd() tea.Cmd {
+	return tea.Batch(
+		statusbar.StatusMessageCmd("Fetching variables…"),
+		model.loader.Load(func(ctx context.Context) (tea.Msg, error) {
+			variables, e := api.Variables(ctx)
+
+			return VariablesMsg{
+				Variables: variables,
+				ctx:       ctx,
+			}, e
+		}),
+	)
+}
+
+// CancelCmds cancels loads that are still in progress, if any.
+func (model *Model) CancelCmds() {
+	model.loader.Cancel()
+
+	if model.valueCancel != nil {
+		model.valueCancel()
+		model.valueCancel = nil
+	}
+}
+
+// valueCmd downloads the value of the selected variable unless it has been downloaded already,
+// cancelling the download of the previously selected one.
+func (model *Model) valueCmd() tea.Cmd {
+	item := model.selectedItem()
+	if item == nil || item.Value != nil {
+		return nil
+	}
+
+	if model.valueCancel != nil {
+		model.valueCancel()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	model.valueCancel = cancel
+	variable := item.Variable
+
+	return func() tea.Msg {
+		content, e := api.VariableValue(ctx, variable)
+
+		// The variable is no longer selected.
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		return ValueMsg{
+			Variable: variable,
+			Value:    Value{Content: content, Err: e},
+		}
+	}
+}
+
+// listedItems returns variables that pass the integration flow filter.
+func (model *Model) listedItems() []list.Item {
+	items := make([]list.Item, 0, len(model.all))
+
+	for _, item := range model.all {
+		if !model.filterByFlow || item.IntegrationFlow == model.flowID {
+			items = append(items, item)
+		}
+	}
+
+	return items
+}
+
+func (model *Model) selectedItem() *Item {
+	selectedItem := model.variables.SelectedItem()
+	if selectedItem == nil {
+		return nil
+	}
+
+	item := selectedItem.(Item)
+
+	return &item
+}
+
+func sameVariable(a, b api.Variable) bool {
+	return a.VariableName == b.VariableName && a.IntegrationFlow == b.IntegrationFlow
+}
+
+// sorted orders variables by integration flow, global variables first, and by name.
+func sorted(variables []api.Variable) []api.Variable {
+	variables = slices.Clone(variables)
+
+	slices.SortStableFunc(variables, func(a, b api.Variable) int {
+		if a.Visibility != b.Visibility {
+			if a.Visibility == api.VariableVisibilityGlobal {
+				return -1
+			}
+
+			if b.Visibility == api.VariableVisibilityGlobal {
+				return 1
+			}
+		}
+
+		if c := cmp.Compare(a.IntegrationFlow, b.IntegrationFlow); c != 0 {
+			return c
+		}
+
+		return cmp.Compare(a.VariableName, b.VariableName)
+	})
+
+	return variables
+}
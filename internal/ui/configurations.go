@@ -0,0 +1,37 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+)
+
+// openConfigurationsScreen lists externalized parameters of the integration flow selected in the artifacts pane.
+func (model *Model) openConfigurationsScreen() []tea.Cmd {
+	if model.activePane != ArtifactsPane ||
+		model.artifacts.SelectedArtifactType() != api.SupportedArtifactTypes().Designtime.IntegrationFlow.Name ||
+		model.artifacts.SelectedArtifactID() == nil {
+		return nil
+	}
+
+	model.screen = ConfigurationsScreen
+
+	return []tea.Cmd{
+		model.configurations.Open(*model.artifacts.SelectedArtifactID(), *model.artifacts.SelectedArtifactName()),
+	}
+}
+
+// updateConfigurationsScreen handles keys of the configurations screen.
+func (model *Model) updateConfigurationsScreen(msg tea.KeyMsg) []tea.Cmd {
+	switch {
+	case key.Matches(msg, model.common.KeyMap.Close):
+		model.closeScreen()
+
+	default:
+		_, cmd := model.configurations.Update(msg)
+
+		return []tea.Cmd{cmd}
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// openCredentialsScreen lists user credentials, OAuth2 client credentials and secure parameters of the tenant.
+func (model *Model) openCredentialsScreen() []tea.Cmd {
+	model.screen = CredentialsScreen
+
+	return []tea.Cmd{model.credentials.Open()}
+}
+
+// updateCredentialsScreen handles keys of the credentials screen. The close key clears the filter first, if any.
+func (model *Model) updateCredentialsScreen(msg tea.KeyMsg) []tea.Cmd {
+	switch {
+	case key.Matches(msg, model.common.KeyMap.Close) && !model.credentials.FilterApplied():
+		model.closeScreen()
+
+	default:
+		_, cmd := model.credentials.Update(msg)
+
+		return []tea.Cmd{cmd}
+	}
+
+	return nil
+}
@@ -0,0 +1,59 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/vadimklimov/cpi-navigator/internal/config"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
+)
+
+// openDataStoresScreen lists data stores of the runtime.
+func (model *Model) openDataStoresScreen() []tea.Cmd {
+	model.screen = DataStoresScreen
+
+	return []tea.Cmd{model.datastores.Open()}
+}
+
+// updateDataStoresScreen handles keys of the data stores screen. The close key returns from entries
+// of a data store to the list of data stores first.
+func (model *Model) updateDataStoresScreen(msg tea.KeyMsg) []tea.Cmd {
+	switch {
+	case key.Matches(msg, model.common.KeyMap.Close):
+		if !model.datastores.Back() {
+			model.closeScreen()
+		}
+
+	case key.Matches(msg, model.common.KeyMap.Delete):
+		return model.confirmDataStoreEntryDeletion()
+
+	default:
+		_, cmd := model.datastores.Update(msg)
+
+		return []tea.Cmd{cmd}
+	}
+
+	return nil
+}
+
+// confirmDataStoreEntryDeletion asks to confirm deletion of the data store entry selected on the data stores screen.
+func (model *Model) confirmDataStoreEntryDeletion() []tea.Cmd {
+	entry := model.datastores.SelectedEntry()
+	if entry == nil {
+		return nil
+	}
+
+	if !config.TenantWriteMode() {
+		return []tea.Cmd{
+			statusbar.StatusMessageCmd("Enable write mode in the configuration to delete data store entries"),
+		}
+	}
+
+	model.confirm.Open("Delete",
+		fmt.Sprintf("Delete entry %s from data store %s? Its payload can't be restored.", entry.ID, entry.DataStoreName),
+		model.datastores.DeleteCmd())
+	model.showConfirm = true
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package ui
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/vadimklimov/cpi-navigator/internal/config"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
+)
+
+// confirmDeployment asks to confirm deployment of the artifact selected in the artifacts pane. If the artifact
+// is deployed already, the question says that the version in the runtime is replaced.
+func (model *Model) confirmDeployment() []tea.Cmd {
+	if model.activePane != ArtifactsPane || model.artifacts.SelectedArtifactID() == nil {
+		return nil
+	}
+
+	if !config.TenantWriteMode() {
+		return []tea.Cmd{
+			statusbar.StatusMessageCmd("Enable write mode in the configuration to deploy artifacts"),
+		}
+	}
+
+	title := "Deploy"
+	question := fmt.Sprintf("Deploy the active version of %s to the runtime?", *model.artifacts.SelectedArtifactName())
+
+	if version := model.artifacts.SelectedArtifactRuntimeVersion(); version != nil {
+		title = "Redeploy"
+		question = fmt.Sprintf("Redeploy %s? Version %s in the runtime is replaced with the active version.",
+			*model.artifacts.SelectedArtifactName(), *version)
+	}
+
+	model.confirm.Open(title, question, model.artifacts.DeployCmd())
+	model.showConfirm = true
+
+	return nil
+}
+
+// confirmUndeployment asks to confirm undeployment of the artifact selected in the artifacts pane.
+func (model *Model) confirmUndeployment() []tea.Cmd {
+	if model.activePane != ArtifactsPane || model.artifacts.SelectedArtifactID() == nil {
+		return nil
+	}
+
+	if !config.TenantWriteMode() {
+		return []tea.Cmd{
+			statusbar.StatusMessageCmd("Enable write mode in the configuration to undeploy artifacts"),
+		}
+	}
+
+	if !model.artifacts.SelectedArtifactMayBeDeployed() {
+		return []tea.Cmd{
+			statusbar.StatusMessageCmd(*model.artifacts.SelectedArtifactName() + " is not deployed"),
+		}
+	}
+
+	model.confirm.Open("Undeploy",
+		fmt.Sprintf("Undeploy %s from the runtime?",
+			*model.artifacts.SelectedArtifactName()),
+		model.artifacts.UndeployCmd())
+	model.showConfirm = true
+
+	return nil
+}
@@ -0,0 +1,48 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/download"
+)
+
+// openDownload asks for the directory to download the content package or the integration artifact selected
+// in the active pane to. While a download is in progress, the dialog offers to cancel it instead.
+func (model *Model) openDownload() []tea.Cmd {
+	if model.download.Downloading() {
+		model.showDownload = true
+
+		return []tea.Cmd{model.download.Open(download.Target{})}
+	}
+
+	var target download.Target
+
+	switch model.activePane {
+	case PackagesPane:
+		if model.packages.SelectedPackageID() == nil {
+			return nil
+		}
+
+		target = download.Target{
+			ID:   *model.packages.SelectedPackageID(),
+			Name: *model.packages.SelectedPackageName(),
+		}
+
+	case ArtifactsPane:
+		if model.artifacts.SelectedArtifactID() == nil {
+			return nil
+		}
+
+		target = download.Target{
+			ID:           *model.artifacts.SelectedArtifactID(),
+			Name:         *model.artifacts.SelectedArtifactName(),
+			ArtifactType: model.artifacts.SelectedArtifactType(),
+		}
+
+	default:
+		return nil
+	}
+
+	model.showDownload = true
+
+	return []tea.Cmd{model.download.Open(target)}
+}
@@ -0,0 +1,28 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// openKeystoreScreen lists entries of the tenant keystore.
+func (model *Model) openKeystoreScreen() []tea.Cmd {
+	model.screen = KeystoreScreen
+
+	return []tea.Cmd{model.keystore.Open()}
+}
+
+// updateKeystoreScreen handles keys of the keystore screen.
+func (model *Model) updateKeystoreScreen(msg tea.KeyMsg) []tea.Cmd {
+	switch {
+	case key.Matches(msg, model.common.KeyMap.Close):
+		model.closeScreen()
+
+	default:
+		_, cmd := model.keystore.Update(msg)
+
+		return []tea.Cmd{cmd}
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+package ui
+
+const (
+	// Window size used until the terminal reports its actual size.
+	defaultWindowWidth  = 154
+	defaultWindowHeight = 40
+
+	// Below this width, panes are stacked in a single column, unless the window is too short to fit both.
+	minSideBySideWidth = 100
+
+	maxAttributesPaneHeight = 12
+	minAttributesPaneHeight = 4
+	minDatasetHeight        = 1
+
+	// Space kept around the search palette, so that the panes underneath remain visible.
+	searchPaletteMargin = 4
+)
+
+// resize recomputes sizes of all components to fit the terminal window.
+func (model *Model) resize(width, height int) {
+	model.width = width
+	model.height = height
+
+	styles := model.common.Styles
+
+	barsHeight := 0
+	if model.layout == LayoutNormal {
+		barsHeight = styles.TitleBar.Area.GetVerticalFrameSize() + 1 +
+			styles.StatusBar.Area.GetVerticalFrameSize() + 1
+	}
+
+	paneBorderWidth := styles.ContentPackagesPane.Inactive.GetHorizontalFrameSize()
+	paneBorderHeight := styles.ContentPackagesPane.Inactive.GetVerticalFrameSize()
+	attributesBorderHeight := styles.AttributesPane.Area.GetVerticalFrameSize()
+
+	packagesTitleHeight := styles.ContentPackagesPane.Title.GetVerticalFrameSize() + 1
+	artifactsTabsHeight := styles.IntegrationArtifactsPane.Tabs.Area.GetVerticalFrameSize() + 1
+
+	// Each pane keeps room for its title or tabs and at least one row of its list, so that panes don't
+	// collapse in a short window. The attributes pane gives up its rows first.
+	minPaneHeight := max(packagesTitleHeight, artifactsTabsHeight) + minDatasetHeight
+
+	// Attributes pane takes up to a third of the available height, panes take the rest.
+	attributesHeight := min(maxAttributesPaneHeight, max(minAttributesPaneHeight, (height-barsHeight)/3),
+		max(1, height-barsHeight-attributesBorderHeight-minPaneHeight-paneBorderHeight))
+	panesHeight := height - barsHeight - attributesHeight - attributesBorderHeight
+	model.stacked = width < minSideBySideWidth && panesHeight >= 2*(minPaneHeight+paneBorderHeight)
+
+	var (
+		packagesWidth, artifactsWidth   int
+		packagesHeight, artifactsHeight int
+	)
+
+	if model.stacked {
+		packagesWidth = width - paneBorderWidth
+		artifactsWidth = width - paneBorderWidth
+		packagesHeight = panesHeight/2 - paneBorderHeight
+		artifactsHeight = panesHeight - panesHeight/2 - paneBorderHeight
+	} else {
+		packagesWidth = width*2/5 - paneBorderWidth
+		artifactsWidth = width - width*2/5 - paneBorderWidth
+		packagesHeight = panesHeight - paneBorderHeight
+		artifactsHeight = panesHeight - paneBorderHeight
+	}
+
+	packagesWidth = max(1, packagesWidth)
+	artifactsWidth = max(1, artifactsWidth)
+	packagesHeight = max(minPaneHeight, packagesHeight)
+	artifactsHeight = max(minPaneHeight, artifactsHeight)
+
+	styles.ContentPackagesPane.Inactive = styles.ContentPackagesPane.Inactive.
+		Width(packagesWidth).
+		Height(packagesHeight)
+	styles.ContentPackagesPane.Active = styles.ContentPackagesPane.Active.
+		Width(packagesWidth).
+		Height(packagesHeight)
+	styles.ContentPackagesPane.Title = styles.ContentPackagesPane.Title.
+		Width(packagesWidth)
+
+	styles.IntegrationArtifactsPane.Inactive = styles.IntegrationArtifactsPane.Inactive.
+		Width(artifactsWidth).
+		Height(artifactsHeight)
+	styles.IntegrationArtifactsPane.Active = styles.IntegrationArtifactsPane.Active.
+		Width(artifactsWidth).
+		Height(artifactsHeight)
+	styles.IntegrationArtifactsPane.Tabs.Area = styles.IntegrationArtifactsPane.Tabs.Area.
+		Width(artifactsWidth)
+
+	attributesWidth := max(1, width-styles.AttributesPane.Area.GetHorizontalFrameSize())
+	styles.AttributesPane.Area = styles.AttributesPane.Area.
+		Width(attributesWidth).
+		Height(attributesHeight).
+		MaxHeight(attributesHeight + attributesBorderHeight)
+
+	styles.TitleBar.Area = styles.TitleBar.Area.Width(width)
+	styles.StatusBar.Area = styles.StatusBar.Area.Width(width)
+
+	model.packages.SetSize(packagesWidth, packagesHeight-packagesTitleHeight)
+	model.artifacts.SetSize(artifactsWidth, artifactsHeight-artifactsTabsHeight)
+	model.tabs.SetWidth(artifactsWidth)
+	model.attributes.SetSize(attributesWidth, attributesHeight)
+	model.statusbar.SetWidth(width)
+	model.messages.SetSize(width, height-barsHeight)
+	model.foundMessages.SetSize(width, height-barsHeight)
+	model.message.SetSize(width, height-barsHeight)
+	model.configurations.SetSize(width, height-barsHeight)
+	model.archive.SetSize(width, height-barsHeight)
+	model.keystore.SetSize(width, height-barsHeight)
+	model.credentials.SetSize(width, height-barsHeight)
+	model.datastores.SetSize(width, height-barsHeight)
+	model.variables.SetSize(width, height-barsHeight)
+	model.queues.SetSize(width, height-barsHeight)
+	model.messageSearch.SetSize(width-searchPaletteMargin, height-searchPaletteMargin)
+	model.search.SetSize(width-searchPaletteMargin, height-searchPaletteMargin)
+	model.confirm.SetSize(width-searchPaletteMargin, height-searchPaletteMargin)
+	model.download.SetSize(width-searchPaletteMargin, height-searchPaletteMargin)
+	model.upload.SetSize(width-searchPaletteMargin, height-searchPaletteMargin)
+}
@@ -0,0 +1,143 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/messagespane/messagelog"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/searchpalette"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/tools/browser"
+)
+
+// openMessagesScreen lists message processing logs of the integration flow selected in the artifacts pane.
+func (model *Model) openMessagesScreen() []tea.Cmd {
+	if model.activePane != ArtifactsPane ||
+		model.artifacts.SelectedArtifactType() != api.SupportedArtifactTypes().Designtime.IntegrationFlow.Name ||
+		model.artifacts.SelectedArtifactID() == nil {
+		return nil
+	}
+
+	model.screen = MessagesScreen
+
+	return []tea.Cmd{
+		model.messages.Open(*model.artifacts.SelectedArtifactID(), *model.artifacts.SelectedArtifactName()),
+	}
+}
+
+// openMessageDetailScreen displays details of the message. Closing the details returns to the current screen.
+func (model *Model) openMessageDetailScreen(log *api.MessageProcessingLog) []tea.Cmd {
+	if log == nil {
+		return nil
+	}
+
+	model.detailReturnScreen = model.screen
+	model.screen = MessageDetailScreen
+
+	return []tea.Cmd{model.message.Open(*log)}
+}
+
+// jumpToIntegrationFlow selects the integration flow that processed the message in the artifacts pane.
+func (model *Model) jumpToIntegrationFlow(log *api.MessageProcessingLog) []tea.Cmd {
+	if log == nil {
+		return nil
+	}
+
+	artifact := log.IntegrationArtifact
+	if artifact.PackageID == "" || artifact.ID == "" {
+		return []tea.Cmd{
+			statusbar.StatusMessageCmd("Content package of integration flow " + log.IntegrationFlowName + " is unknown"),
+		}
+	}
+
+	return model.jumpTo(searchpalette.Entry{
+		PackageID:    artifact.PackageID,
+		PackageName:  artifact.PackageName,
+		ArtifactType: api.SupportedArtifactTypes().Designtime.IntegrationFlow.Name,
+		ArtifactID:   artifact.ID,
+		ArtifactName: artifact.Name,
+	})
+}
+
+// updateMessagesScreen handles keys of the message processing logs screen.
+func (model *Model) updateMessagesScreen(msg tea.KeyMsg) []tea.Cmd {
+	switch {
+	case key.Matches(msg, model.common.KeyMap.Close):
+		model.closeScreen()
+
+	case key.Matches(msg, model.common.KeyMap.Open):
+		if url := model.messages.SelectedMessageLogWebUIURL(); url != nil {
+			return []tea.Cmd{browser.OpenURLCmd(url)}
+		}
+
+	case key.Matches(msg, model.common.KeyMap.Enter):
+		return model.openMessageDetailScreen(model.messages.SelectedMessageLog())
+
+	default:
+		_, cmd := model.messages.Update(msg)
+
+		return []tea.Cmd{cmd}
+	}
+
+	return nil
+}
+
+// updateMessageSearchScreen handles keys of the message search results screen.
+func (model *Model) updateMessageSearchScreen(msg tea.KeyMsg) []tea.Cmd {
+	switch {
+	case key.Matches(msg, model.common.KeyMap.Close):
+		model.closeScreen()
+
+	case key.Matches(msg, model.common.KeyMap.Filter):
+		model.showMessageSearch = true
+
+		return []tea.Cmd{model.messageSearch.Open()}
+
+	case key.Matches(msg, model.common.KeyMap.Open):
+		if url := model.foundMessages.SelectedMessageLogWebUIURL(); url != nil {
+			return []tea.Cmd{browser.OpenURLCmd(url)}
+		}
+
+	case key.Matches(msg, model.common.KeyMap.Enter):
+		return model.openMessageDetailScreen(model.foundMessages.SelectedMessageLog())
+
+	case key.Matches(msg, model.common.KeyMap.GoTo):
+		return model.jumpToIntegrationFlow(model.foundMessages.SelectedMessageLog())
+
+	default:
+		_, cmd := model.foundMessages.Update(msg)
+
+		return []tea.Cmd{cmd}
+	}
+
+	return nil
+}
+
+// updateMessageDetailScreen handles keys of the message processing log detail screen.
+func (model *Model) updateMessageDetailScreen(msg tea.KeyMsg) []tea.Cmd {
+	switch {
+	case key.Matches(msg, model.common.KeyMap.Close):
+		// The viewed attachment is closed first.
+		if !model.message.Back() {
+			model.message.CancelCmds()
+			model.screen = model.detailReturnScreen
+		}
+
+	case key.Matches(msg, model.common.KeyMap.Open):
+		if url := messagelog.WebUIURL(model.message.MessageLog()); url != nil {
+			return []tea.Cmd{browser.OpenURLCmd(url)}
+		}
+
+	case key.Matches(msg, model.common.KeyMap.GoTo):
+		log := model.message.MessageLog()
+
+		return model.jumpToIntegrationFlow(&log)
+
+	default:
+		_, cmd := model.message.Update(msg)
+
+		return []tea.Cmd{cmd}
+	}
+
+	return nil
+}
@@ -0,0 +1,121 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/vadimklimov/cpi-navigator/internal/config"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
+)
+
+// openQueuesScreen lists JMS queues of the runtime.
+func (model *Model) openQueuesScreen() []tea.Cmd {
+	model.screen = QueuesScreen
+
+	return []tea.Cmd{model.queues.Open()}
+}
+
+// updateQueuesScreen handles keys of the queues screen. The close key cancels the choice of the queue to move
+// a message to, and returns from messages of a queue to the list of queues first.
+func (model *Model) updateQueuesScreen(msg tea.KeyMsg) []tea.Cmd {
+	switch {
+	case key.Matches(msg, model.common.KeyMap.Close):
+		if !model.queues.Back() {
+			model.closeScreen()
+		}
+
+	case key.Matches(msg, model.common.KeyMap.Enter) && model.queues.ChoosingTarget():
+		return model.confirmQueueMessageMove()
+
+	case key.Matches(msg, model.common.KeyMap.RetryMessage):
+		return model.confirmQueueMessageRetry()
+
+	case key.Matches(msg, model.common.KeyMap.Move):
+		return model.chooseQueueMessageTarget()
+
+	case key.Matches(msg, model.common.KeyMap.Delete):
+		return model.confirmQueueMessageDeletion()
+
+	default:
+		_, cmd := model.queues.Update(msg)
+
+		return []tea.Cmd{cmd}
+	}
+
+	return nil
+}
+
+// confirmQueueMessageRetry asks to confirm retry of the queue message selected on the queues screen.
+func (model *Model) confirmQueueMessageRetry() []tea.Cmd {
+	message := model.queues.SelectedMessage()
+	if message == nil {
+		return nil
+	}
+
+	if !config.TenantWriteMode() {
+		return []tea.Cmd{statusbar.StatusMessageCmd("Enable write mode in the configuration to retry queue messages")}
+	}
+
+	model.confirm.Open("Retry",
+		fmt.Sprintf("Retry message %s of queue %s? It is delivered to its consumer again.", message.ID, message.QueueName),
+		model.queues.RetryCmd())
+	model.showConfirm = true
+
+	return nil
+}
+
+// chooseQueueMessageTarget starts choosing the queue to move the queue message selected on the queues screen to.
+func (model *Model) chooseQueueMessageTarget() []tea.Cmd {
+	if model.queues.SelectedMessage() == nil {
+		return nil
+	}
+
+	if !config.TenantWriteMode() {
+		return []tea.Cmd{statusbar.StatusMessageCmd("Enable write mode in the configuration to move queue messages")}
+	}
+
+	model.queues.ChooseTarget()
+
+	return []tea.Cmd{statusbar.StatusMessageCmd("Select the queue to move the message to")}
+}
+
+// confirmQueueMessageMove asks to confirm the move of the queue message to the queue selected on the queues screen.
+func (model *Model) confirmQueueMessageMove() []tea.Cmd {
+	message := model.queues.MovingMessage()
+	target := model.queues.SelectedQueue()
+
+	if message == nil || target == nil {
+		return nil
+	}
+
+	if target.Name == message.QueueName {
+		return []tea.Cmd{statusbar.StatusMessageCmd("Select a queue other than " + message.QueueName)}
+	}
+
+	model.confirm.Open("Move",
+		fmt.Sprintf("Move message %s from queue %s to queue %s?", message.ID, message.QueueName, target.Name),
+		model.queues.MoveCmd(target.Name))
+	model.showConfirm = true
+
+	return nil
+}
+
+// confirmQueueMessageDeletion asks to confirm deletion of the queue message selected on the queues screen.
+func (model *Model) confirmQueueMessageDeletion() []tea.Cmd {
+	message := model.queues.SelectedMessage()
+	if message == nil {
+		return nil
+	}
+
+	if !config.TenantWriteMode() {
+		return []tea.Cmd{statusbar.StatusMessageCmd("Enable write mode in the configuration to delete queue messages")}
+	}
+
+	model.confirm.Open("Delete",
+		fmt.Sprintf("Delete message %s from queue %s? It can't be restored.", message.ID, message.QueueName),
+		model.queues.DeleteCmd())
+	model.showConfirm = true
+
+	return nil
+}
@@ -12,7 +12,7 @@ func OpenURLCmd(url *url.URL) tea.Cmd {
 	return func() tea.Msg {
 		if url != nil {
 			if e := browser.OpenURL(url.String()); e != nil {
-				return err.ErrorMsg(e)
+				return err.ErrorMsg{Err: e}
 			}
 		}
 
@@ -0,0 +1,44 @@
+package editor
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+)
+
+// OpenFileCmd opens the file in the editor set with the VISUAL or EDITOR environment variable.
+// The terminal is handed over to the editor until it exits.
+func OpenFileCmd(path string) tea.Cmd {
+	command := editorCommand()
+	if len(command) == 0 {
+		return func() tea.Msg {
+			return err.ErrorMsg{Err: errors.New("set the EDITOR environment variable to open files in an editor")}
+		}
+	}
+
+	cmd := exec.Command(command[0], append(command[1:], path)...)
+
+	return tea.ExecProcess(cmd, func(e error) tea.Msg {
+		if e != nil {
+			return err.ErrorMsg{Err: fmt.Errorf("error opening %s in %s: %w", path, command[0], e)}
+		}
+
+		return nil
+	})
+}
+
+// editorCommand returns the editor command with its arguments, e.g. "code --wait".
+func editorCommand() []string {
+	for _, variable := range []string{"VISUAL", "EDITOR"} {
+		if command := strings.Fields(os.Getenv(variable)); len(command) > 0 {
+			return command
+		}
+	}
+
+	return nil
+}
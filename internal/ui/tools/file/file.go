@@ -0,0 +1,80 @@
+package file
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+)
+
+// Temporary directories created during the session.
+var (
+	tempDirs     []string
+	tempDirsLock sync.Mutex
+)
+
+// SafeName replaces characters that aren't allowed in file names.
+func SafeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`/\:*?"<>|`, r) || r < ' ' {
+			return '_'
+		}
+
+		return r
+	}, name)
+}
+
+// CreateUnique creates a new file with the name and the extension in the directory. Existing files are never
+// overwritten: if the name is taken, a numeric suffix is appended to it. The file is returned together with
+// its absolute path.
+func CreateUnique(dir, name, extension string) (*os.File, string, error) {
+	path := filepath.Join(dir, name+extension)
+
+	for attempt := 1; ; attempt++ {
+		file, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+		if errors.Is(err, os.ErrExist) {
+			path = filepath.Join(dir, fmt.Sprintf("%s_%d%s", name, attempt, extension))
+
+			continue
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		if absolutePath, err := filepath.Abs(path); err == nil {
+			path = absolutePath
+		}
+
+		return file, path, nil
+	}
+}
+
+// CreateTempDir creates a new temporary directory, which is removed with RemoveTempDirs.
+func CreateTempDir(pattern string) (string, error) {
+	dir, err := os.MkdirTemp("", pattern)
+	if err != nil {
+		return "", err
+	}
+
+	tempDirsLock.Lock()
+	defer tempDirsLock.Unlock()
+
+	tempDirs = append(tempDirs, dir)
+
+	return dir, nil
+}
+
+// RemoveTempDirs removes temporary directories created during the session together with their content.
+func RemoveTempDirs() {
+	tempDirsLock.Lock()
+	defer tempDirsLock.Unlock()
+
+	for _, dir := range tempDirs {
+		_ = os.RemoveAll(dir)
+	}
+
+	tempDirs = nil
+}
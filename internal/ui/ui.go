@@ -1,45 +1,92 @@
 package ui
 
 import (
+	"fmt"
+	"maps"
+
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/spinner"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/charmbracelet/log"
 	"github.com/vadimklimov/cpi-navigator/internal/appinfo"
 	"github.com/vadimklimov/cpi-navigator/internal/config"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/common"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/common/err"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/common/overlay"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/archivepane/resource"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/components/artifactspane/integrationartifact"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/components/artifactspane/tab"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/components/attributespane/attribute"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/configurationspane/configuration"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/confirmdialog"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/credentialspane/credential"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/datastorespane/datastore"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/download"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/keystorepane/entry"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/messagespane/messagedetail"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/messagespane/messagelog"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/messagespane/messagesearch"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/components/packagespane/contentpackage"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/queuespane/queue"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/searchpalette"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/components/titlebar"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/upload"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/variablespane/variable"
 	"github.com/vadimklimov/cpi-navigator/internal/ui/tools/browser"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/tools/file"
 )
 
 func Start() error {
 	program := tea.NewProgram(NewModel(), tea.WithAltScreen())
 
-	if _, err := program.Run(); err != nil {
-		log.Fatal("Program failed to start", "err", err)
-	}
+	// Resources of archives are extracted to temporary directories to be opened in an editor.
+	defer file.RemoveTempDirs()
 
-	return nil
+	// The error is returned rather than logged fatally, so that the temporary directories are removed.
+	_, e := program.Run()
+
+	return e
 }
 
 type Model struct {
-	common        common.Common
-	packages      *contentpackage.Model
-	artifacts     *integrationartifact.Model
-	attributes    *attribute.Model
-	tabs          *tab.Model
-	titlebar      *titlebar.Model
-	statusbar     *statusbar.Model
-	layout        int
-	activePane    int
-	showArtifacts bool
-	err           error
+	common             common.Common
+	packages           *contentpackage.Model
+	artifacts          *integrationartifact.Model
+	attributes         *attribute.Model
+	tabs               *tab.Model
+	titlebar           *titlebar.Model
+	statusbar          *statusbar.Model
+	search             *searchpalette.Model
+	messages           *messagelog.Model
+	foundMessages      *messagelog.Model
+	messageSearch      *messagesearch.Model
+	message            *messagedetail.Model
+	configurations     *configuration.Model
+	archive            *resource.Model
+	keystore           *entry.Model
+	credentials        *credential.Model
+	datastores         *datastore.Model
+	variables          *variable.Model
+	queues             *queue.Model
+	confirm            *confirmdialog.Model
+	download           *download.Model
+	upload             *upload.Model
+	layout             int
+	screen             int
+	detailReturnScreen int
+	width              int
+	height             int
+	stacked            bool
+	activePane         int
+	showArtifacts      bool
+	showSearch         bool
+	showMessageSearch  bool
+	showConfirm        bool
+	showDownload       bool
+	showUpload         bool
+	failures           map[string]err.ErrorMsg
 }
 
 type LayoutMsg int
@@ -49,6 +96,20 @@ const (
 	LayoutCompact
 )
 
+const (
+	WorkspaceScreen = iota
+	MessagesScreen
+	MessageSearchScreen
+	MessageDetailScreen
+	ConfigurationsScreen
+	ArchiveScreen
+	KeystoreScreen
+	CredentialsScreen
+	DataStoresScreen
+	VariablesScreen
+	QueuesScreen
+)
+
 const (
 	PackagesPane = iota
 	ArtifactsPane
@@ -66,19 +127,39 @@ func NewModel() *Model {
 		layout = LayoutCompact
 	}
 
-	return &Model{
-		common:        common.New(),
-		packages:      contentpackage.New(),
-		artifacts:     integrationartifact.New(),
-		attributes:    attribute.New(),
-		tabs:          tab.New(),
-		titlebar:      titlebar.New(),
-		statusbar:     statusbar.New(),
-		layout:        layout,
-		activePane:    NoPane,
-		showArtifacts: false,
-		err:           nil,
+	model := &Model{
+		common:         common.New(),
+		packages:       contentpackage.New(),
+		artifacts:      integrationartifact.New(),
+		attributes:     attribute.New(),
+		tabs:           tab.New(),
+		titlebar:       titlebar.New(),
+		statusbar:      statusbar.New(),
+		search:         searchpalette.New(),
+		messages:       messagelog.New(),
+		foundMessages:  messagelog.NewSearch(),
+		messageSearch:  messagesearch.New(),
+		message:        messagedetail.New(),
+		configurations: configuration.New(),
+		archive:        resource.New(),
+		keystore:       entry.New(),
+		credentials:    credential.New(),
+		datastores:     datastore.New(),
+		variables:      variable.New(),
+		queues:         queue.New(),
+		confirm:        confirmdialog.New(),
+		download:       download.New(),
+		upload:         upload.New(),
+		layout:         layout,
+		screen:         WorkspaceScreen,
+		activePane:     NoPane,
+		showArtifacts:  false,
+		failures:       make(map[string]err.ErrorMsg),
 	}
+
+	model.resize(defaultWindowWidth, defaultWindowHeight)
+
+	return model
 }
 
 func (model Model) Init() tea.Cmd {
@@ -97,30 +178,130 @@ func (model Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	cmds := make([]tea.Cmd, 0)
 
 	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		model.resize(msg.Width, msg.Height)
+
 	case tea.KeyMsg:
+		// While the confirmation dialog is open, keys are consumed by the dialog.
+		if model.showConfirm {
+			_, cmd := model.confirm.Update(msg)
+			cmds = append(cmds, cmd)
+
+			break
+		}
+
+		// While the download dialog is open, keys are consumed by the dialog.
+		if model.showDownload {
+			_, cmd := model.download.Update(msg)
+			cmds = append(cmds, cmd)
+
+			break
+		}
+
+		// While the upload form is open, keys are consumed by the form.
+		if model.showUpload {
+			_, cmd := model.upload.Update(msg)
+			cmds = append(cmds, cmd)
+
+			break
+		}
+
+		// While the search palette is open, keys are consumed by the palette.
+		if model.showSearch {
+			_, cmd := model.search.Update(msg)
+			cmds = append(cmds, cmd)
+
+			break
+		}
+
+		// While the message search form is open, keys are consumed by the form.
+		if model.showMessageSearch {
+			_, cmd := model.messageSearch.Update(msg)
+			cmds = append(cmds, cmd)
+
+			break
+		}
+
+		// While a parameter value is being edited, keys are consumed by the input.
+		if model.screen == ConfigurationsScreen && model.configurations.Editing() {
+			_, cmd := model.configurations.Update(msg)
+			cmds = append(cmds, cmd)
+
+			break
+		}
+
+		// While the filter is being edited, keys are consumed by the filter input.
+		if model.filtering() {
+			cmds = append(cmds, model.updateActivePane(msg)...)
+
+			break
+		}
+
 		switch {
 		case key.Matches(msg, model.common.KeyMap.Quit):
 			return model, tea.Quit
 
-		case key.Matches(msg, model.common.KeyMap.Up), key.Matches(msg, model.common.KeyMap.Down):
-			switch model.activePane {
-			case PackagesPane:
-				model.showArtifacts = false
-				model.packages.Update(msg)
-				cmds = append(cmds,
-					model.artifacts.Init(),
-					model.tabs.Init(),
-					model.attributes.AttributesCmd(model.packages.SelectedPackageAttributes()),
-				)
+		case key.Matches(msg, model.common.KeyMap.Layout):
+			cmds = append(cmds, model.ToggleLayoutCmd())
 
-			case ArtifactsPane:
-				model.showArtifacts = true
-				model.artifacts.Update(msg)
-				cmds = append(cmds,
-					model.attributes.AttributesCmd(model.artifacts.SelectedArtifactAttributes()),
-				)
+		case key.Matches(msg, model.common.KeyMap.Search):
+			model.showSearch = true
+			cmds = append(cmds, model.search.Open())
+
+		case key.Matches(msg, model.common.KeyMap.MessageSearch):
+			model.showMessageSearch = true
+			cmds = append(cmds, model.messageSearch.Open())
+
+		case key.Matches(msg, model.common.KeyMap.Retry):
+			model.pruneFailures()
+
+			for source, failure := range model.failures {
+				cmds = append(cmds, err.RetryCmd(source), failure.Retry)
+				delete(model.failures, source)
 			}
 
+			model.statusbar.Update(msg)
+
+		case key.Matches(msg, model.common.KeyMap.Dismiss):
+			model.statusbar.Update(msg)
+
+		// Other keys are specific to the screen.
+		case model.screen == MessagesScreen:
+			cmds = append(cmds, model.updateMessagesScreen(msg)...)
+
+		case model.screen == MessageSearchScreen:
+			cmds = append(cmds, model.updateMessageSearchScreen(msg)...)
+
+		case model.screen == MessageDetailScreen:
+			cmds = append(cmds, model.updateMessageDetailScreen(msg)...)
+
+		case model.screen == ConfigurationsScreen:
+			cmds = append(cmds, model.updateConfigurationsScreen(msg)...)
+
+		case model.screen == ArchiveScreen:
+			cmds = append(cmds, model.updateArchiveScreen(msg)...)
+
+		case model.screen == KeystoreScreen:
+			cmds = append(cmds, model.updateKeystoreScreen(msg)...)
+
+		case model.screen == CredentialsScreen:
+			cmds = append(cmds, model.updateCredentialsScreen(msg)...)
+
+		case model.screen == DataStoresScreen:
+			cmds = append(cmds, model.updateDataStoresScreen(msg)...)
+
+		case model.screen == VariablesScreen:
+			cmds = append(cmds, model.updateVariablesScreen(msg)...)
+
+		case model.screen == QueuesScreen:
+			cmds = append(cmds, model.updateQueuesScreen(msg)...)
+
+		case key.Matches(msg, model.common.KeyMap.Up),
+			key.Matches(msg, model.common.KeyMap.Down),
+			key.Matches(msg, model.common.KeyMap.Filter),
+			key.Matches(msg, model.common.KeyMap.ClearFilter):
+			cmds = append(cmds, model.updateActivePane(msg)...)
+
 		case key.Matches(msg, model.common.KeyMap.Left), key.Matches(msg, model.common.KeyMap.Right):
 			if model.activePane == ArtifactsPane {
 				model.showArtifacts = true
@@ -167,10 +348,11 @@ func (model Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, model.common.KeyMap.Refresh):
 			switch model.activePane {
 			case PackagesPane:
+				model.search.Reset()
 				cmds = append(cmds,
 					model.artifacts.Init(),
 					model.tabs.Init(),
-					model.packages.ContentPackagesCmd,
+					model.packages.ContentPackagesCmd(),
 				)
 
 			case ArtifactsPane:
@@ -181,6 +363,48 @@ func (model Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 			}
 
+		case key.Matches(msg, model.common.KeyMap.Messages):
+			cmds = append(cmds, model.openMessagesScreen()...)
+
+		case key.Matches(msg, model.common.KeyMap.Configurations):
+			cmds = append(cmds, model.openConfigurationsScreen()...)
+
+		case key.Matches(msg, model.common.KeyMap.Browse):
+			cmds = append(cmds, model.openArchiveScreen()...)
+
+		case key.Matches(msg, model.common.KeyMap.Diagram):
+			cmds = append(cmds, model.openDiagramScreen()...)
+
+		case key.Matches(msg, model.common.KeyMap.Keystore):
+			cmds = append(cmds, model.openKeystoreScreen()...)
+
+		case key.Matches(msg, model.common.KeyMap.Credentials):
+			cmds = append(cmds, model.openCredentialsScreen()...)
+
+		case key.Matches(msg, model.common.KeyMap.DataStores):
+			cmds = append(cmds, model.openDataStoresScreen()...)
+
+		case key.Matches(msg, model.common.KeyMap.Variables):
+			cmds = append(cmds, model.openVariablesScreen()...)
+
+		case key.Matches(msg, model.common.KeyMap.Queues):
+			cmds = append(cmds, model.openQueuesScreen()...)
+
+		case key.Matches(msg, model.common.KeyMap.Deploy):
+			cmds = append(cmds, model.confirmDeployment()...)
+
+		case key.Matches(msg, model.common.KeyMap.Undeploy):
+			cmds = append(cmds, model.confirmUndeployment()...)
+
+		case key.Matches(msg, model.common.KeyMap.Save):
+			cmds = append(cmds, model.openDownload()...)
+
+		case key.Matches(msg, model.common.KeyMap.Upload):
+			cmds = append(cmds, model.openUpload()...)
+
+		case key.Matches(msg, model.common.KeyMap.NewArtifact):
+			cmds = append(cmds, model.openNewArtifact()...)
+
 		case key.Matches(msg, model.common.KeyMap.Open):
 			switch model.activePane {
 			case PackagesPane:
@@ -197,13 +421,19 @@ func (model Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					)
 				}
 			}
-
-		case key.Matches(msg, model.common.KeyMap.Layout):
-			cmds = append(cmds, model.ToggleLayoutCmd())
 		}
 
 	case LayoutMsg:
 		model.layout = int(msg)
+		model.resize(model.width, model.height)
+
+	case contentpackage.ContentPackagesPageMsg:
+		p, cmd := model.packages.Update(msg)
+		model.packages = p.(*contentpackage.Model)
+
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
 
 	case contentpackage.ContentPackagesMsg:
 		model.activePane = PackagesPane
@@ -219,6 +449,22 @@ func (model Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			model.attributes.AttributesCmd(model.packages.SelectedPackageAttributes()),
 		)
 
+	case contentpackage.FilterMatchesMsg:
+		if model.activePane == PackagesPane {
+			cmds = append(cmds, model.updateActivePane(msg)...)
+		} else {
+			_, cmd := model.packages.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+
+	case integrationartifact.FilterMatchesMsg:
+		if model.activePane == ArtifactsPane {
+			cmds = append(cmds, model.updateActivePane(msg)...)
+		} else {
+			_, cmd := model.artifacts.Update(msg)
+			cmds = append(cmds, cmd)
+		}
+
 	case tab.ActiveTabMsg:
 		if model.activePane == ArtifactsPane {
 			model.showArtifacts = true
@@ -229,10 +475,19 @@ func (model Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			)
 		}
 
+	case integrationartifact.ArtifactsPageMsg:
+		a, cmd := model.artifacts.Update(msg)
+		model.artifacts = a.(*integrationartifact.Model)
+
+		if cmd != nil {
+			cmds = append(cmds, cmd)
+		}
+
 	case integrationartifact.IntegrationFlowsMsg,
 		integrationartifact.ValueMappingsMsg,
 		integrationartifact.MessageMappingsMsg,
-		integrationartifact.ScriptCollectionsMsg:
+		integrationartifact.ScriptCollectionsMsg,
+		integrationartifact.RuntimeArtifactsMsg:
 		a, cmd := model.artifacts.Update(msg)
 		model.artifacts = a.(*integrationartifact.Model)
 
@@ -246,6 +501,97 @@ func (model Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			)
 		}
 
+	case searchpalette.IndexPackagesMsg, searchpalette.IndexArtifactsMsg:
+		_, cmd := model.search.Update(msg)
+		cmds = append(cmds, cmd)
+
+	case searchpalette.SelectMsg:
+		model.showSearch = false
+		cmds = append(cmds, model.jumpTo(searchpalette.Entry(msg))...)
+
+	case searchpalette.CloseMsg:
+		model.showSearch = false
+
+	case confirmdialog.CloseMsg:
+		model.showConfirm = false
+
+	case download.CloseMsg:
+		model.showDownload = false
+
+	case download.ProgressMsg, download.DownloadedMsg, download.CancelledMsg:
+		_, cmd := model.download.Update(msg)
+		cmds = append(cmds, cmd)
+		model.statusbar.SetProgress(model.download.Progress())
+
+	case upload.SubmitMsg:
+		model.confirmUpload(upload.Request(msg))
+
+	case upload.CloseMsg:
+		model.showUpload = false
+
+	case upload.StartMsg:
+		_, cmd := model.upload.Update(msg)
+		cmds = append(cmds, cmd)
+
+	case upload.UploadedMsg:
+		model.upload.Update(msg)
+		cmds = append(cmds, model.uploaded(msg)...)
+
+	case upload.CancelledMsg:
+		model.upload.Update(msg)
+		cmds = append(cmds, statusbar.StatusMessageCmd("Cancelled upload of "+msg.Name))
+
+	case integrationartifact.DeploymentMsg:
+		_, cmd := model.artifacts.Update(msg)
+		cmds = append(cmds, cmd)
+
+	case messagelog.MessageLogsPageMsg:
+		_, messagesCmd := model.messages.Update(msg)
+		_, foundMessagesCmd := model.foundMessages.Update(msg)
+		cmds = append(cmds, messagesCmd, foundMessagesCmd)
+
+	case configuration.ConfigurationsMsg, configuration.SavedMsg:
+		_, cmd := model.configurations.Update(msg)
+		cmds = append(cmds, cmd)
+
+	case resource.ArchiveMsg, resource.ViewMsg, resource.ExtractedMsg:
+		_, cmd := model.archive.Update(msg)
+		cmds = append(cmds, cmd)
+
+	case entry.EntriesMsg, entry.ExportedMsg:
+		_, cmd := model.keystore.Update(msg)
+		cmds = append(cmds, cmd)
+
+	case credential.CredentialsMsg, credential.FilterMatchesMsg:
+		_, cmd := model.credentials.Update(msg)
+		cmds = append(cmds, cmd)
+
+	case datastore.StoresMsg, datastore.EntriesMsg, datastore.DownloadedMsg, datastore.DeletedMsg:
+		_, cmd := model.datastores.Update(msg)
+		cmds = append(cmds, cmd)
+
+	case variable.VariablesMsg, variable.ValueMsg:
+		_, cmd := model.variables.Update(msg)
+		cmds = append(cmds, cmd)
+
+	case queue.QueuesMsg, queue.MessagesMsg, queue.RetriedMsg, queue.MovedMsg, queue.DeletedMsg:
+		_, cmd := model.queues.Update(msg)
+		cmds = append(cmds, cmd)
+
+	case messagesearch.SubmitMsg:
+		model.showMessageSearch = false
+		model.message.CancelCmds()
+		model.messages.CancelCmds()
+		model.screen = MessageSearchScreen
+		cmds = append(cmds, model.foundMessages.Search(api.MessageFilter(msg)))
+
+	case messagesearch.CloseMsg:
+		model.showMessageSearch = false
+
+	case messagedetail.DetailMsg, messagedetail.AttachmentMsg:
+		_, cmd := model.message.Update(msg)
+		cmds = append(cmds, cmd)
+
 	case attribute.AttributesMsg:
 		a, cmd := model.attributes.Update(msg)
 		model.attributes = a.(*attribute.Model)
@@ -254,7 +600,41 @@ func (model Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			cmds = append(cmds, cmd)
 		}
 
-	case statusbar.StatusMsg:
+	case spinner.TickMsg:
+		_, packagesCmd := model.packages.Update(msg)
+		_, artifactsCmd := model.artifacts.Update(msg)
+		_, searchCmd := model.search.Update(msg)
+		_, messagesCmd := model.messages.Update(msg)
+		_, foundMessagesCmd := model.foundMessages.Update(msg)
+		_, messageCmd := model.message.Update(msg)
+		_, configurationsCmd := model.configurations.Update(msg)
+		_, archiveCmd := model.archive.Update(msg)
+		_, keystoreCmd := model.keystore.Update(msg)
+		_, credentialsCmd := model.credentials.Update(msg)
+		_, datastoresCmd := model.datastores.Update(msg)
+		_, variablesCmd := model.variables.Update(msg)
+		_, queuesCmd := model.queues.Update(msg)
+		cmds = append(cmds, packagesCmd, artifactsCmd, searchCmd, messagesCmd, foundMessagesCmd, messageCmd,
+			configurationsCmd, archiveCmd, keystoreCmd, credentialsCmd, datastoresCmd, variablesCmd, queuesCmd)
+
+	case err.RetryMsg:
+		_, packagesCmd := model.packages.Update(msg)
+		_, artifactsCmd := model.artifacts.Update(msg)
+		_, searchCmd := model.search.Update(msg)
+		_, messagesCmd := model.messages.Update(msg)
+		_, foundMessagesCmd := model.foundMessages.Update(msg)
+		_, messageCmd := model.message.Update(msg)
+		_, configurationsCmd := model.configurations.Update(msg)
+		_, archiveCmd := model.archive.Update(msg)
+		_, keystoreCmd := model.keystore.Update(msg)
+		_, credentialsCmd := model.credentials.Update(msg)
+		_, datastoresCmd := model.datastores.Update(msg)
+		_, variablesCmd := model.variables.Update(msg)
+		_, queuesCmd := model.queues.Update(msg)
+		cmds = append(cmds, packagesCmd, artifactsCmd, searchCmd, messagesCmd, foundMessagesCmd, messageCmd,
+			configurationsCmd, archiveCmd, keystoreCmd, credentialsCmd, datastoresCmd, variablesCmd, queuesCmd)
+
+	case statusbar.StatusMsg, statusbar.RefreshedMsg:
 		s, cmd := model.statusbar.Update(msg)
 		model.statusbar = s.(*statusbar.Model)
 
@@ -263,23 +643,111 @@ func (model Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case err.ErrorMsg:
-		model.err = msg
+		err.Log(msg.Err)
+
+		if msg.Retryable() {
+			model.failures[msg.Source] = msg
+		}
+
+		model.packages.Update(msg)
+		model.artifacts.Update(msg)
+		model.search.Update(msg)
+		model.messages.Update(msg)
+		model.foundMessages.Update(msg)
+		model.message.Update(msg)
+		model.configurations.Update(msg)
+		model.archive.Update(msg)
+		model.keystore.Update(msg)
+		model.credentials.Update(msg)
+		model.datastores.Update(msg)
+		model.variables.Update(msg)
+		model.queues.Update(msg)
+		model.download.Update(msg)
+		model.upload.Update(msg)
+		model.statusbar.Update(msg)
+		model.statusbar.SetProgress(model.download.Progress())
+
+	default:
+		// Other messages, e.g. cursor blinking, belong to the input that is being edited.
+		switch {
+		case model.showSearch:
+			_, cmd := model.search.Update(msg)
+			cmds = append(cmds, cmd)
+		case model.showMessageSearch:
+			_, cmd := model.messageSearch.Update(msg)
+			cmds = append(cmds, cmd)
+		case model.showDownload:
+			_, cmd := model.download.Update(msg)
+			cmds = append(cmds, cmd)
+		case model.showUpload:
+			_, cmd := model.upload.Update(msg)
+			cmds = append(cmds, cmd)
+		case model.screen == ConfigurationsScreen && model.configurations.Editing():
+			_, cmd := model.configurations.Update(msg)
+			cmds = append(cmds, cmd)
+		}
 	}
 
+	model.statusbar.SetPane(model.refreshedPane())
+
 	return model, tea.Batch(cmds...)
 }
 
 func (model Model) View() string {
-	if model.err != nil {
-		return model.common.Styles.Error.Area.Render(
-			lipgloss.JoinVertical(
-				lipgloss.Center,
-				model.common.Styles.Error.Title.Render("Error"),
-				model.common.Styles.Error.Details.Render(model.err.Error()),
-			),
+	var view string
+
+	switch model.screen {
+	case MessagesScreen:
+		view = model.messages.View()
+	case MessageSearchScreen:
+		view = model.foundMessages.View()
+	case MessageDetailScreen:
+		view = model.message.View()
+	case ConfigurationsScreen:
+		view = model.configurations.View()
+	case ArchiveScreen:
+		view = model.archive.View()
+	case KeystoreScreen:
+		view = model.keystore.View()
+	case CredentialsScreen:
+		view = model.credentials.View()
+	case DataStoresScreen:
+		view = model.datastores.View()
+	case VariablesScreen:
+		view = model.variables.View()
+	case QueuesScreen:
+		view = model.queues.View()
+	default:
+		view = model.workspaceView()
+	}
+
+	if model.layout == LayoutNormal {
+		view = lipgloss.JoinVertical(
+			lipgloss.Center,
+			model.common.Styles.TitleBar.Area.Render(model.titlebar.View()),
+			view,
+			model.common.Styles.StatusBar.Area.Render(model.statusbar.View()),
 		)
 	}
 
+	switch {
+	case model.showConfirm:
+		return overlay.Place(view, model.confirm.View(), model.width, model.height)
+	case model.showDownload:
+		return overlay.Place(view, model.download.View(), model.width, model.height)
+	case model.showUpload:
+		return overlay.Place(view, model.upload.View(), model.width, model.height)
+	case model.showSearch:
+		return overlay.Place(view, model.search.View(), model.width, model.height)
+	case model.showMessageSearch:
+		return overlay.Place(view, model.messageSearch.View(), model.width, model.height)
+	}
+
+	return view
+}
+
+// workspaceView renders the content packages, integration artifacts and attributes panes.
+func (model Model) workspaceView() string {
 	var (
 		packagesPaneStyle, artifactsPaneStyle             lipgloss.Style
 		packagesPane, artifactsPane, artifactsPaneContent string
@@ -315,23 +783,176 @@ func (model Model) View() string {
 
 	artifactsPane = artifactsPaneStyle.Render(artifactsPaneContent)
 
-	if model.layout == LayoutCompact {
-		return lipgloss.JoinVertical(
-			lipgloss.Center,
-			lipgloss.JoinHorizontal(lipgloss.Top, packagesPane, artifactsPane),
-			model.common.Styles.AttributesPane.Area.Render(model.attributes.View()),
-		)
+	panes := lipgloss.JoinHorizontal(lipgloss.Top, packagesPane, artifactsPane)
+	if model.stacked {
+		panes = lipgloss.JoinVertical(lipgloss.Left, packagesPane, artifactsPane)
 	}
 
 	return lipgloss.JoinVertical(
 		lipgloss.Center,
-		model.common.Styles.TitleBar.Area.Render(model.titlebar.View()),
-		lipgloss.JoinHorizontal(lipgloss.Top, packagesPane, artifactsPane),
+		panes,
 		model.common.Styles.AttributesPane.Area.Render(model.attributes.View()),
-		model.common.Styles.StatusBar.Area.Render(model.statusbar.View()),
 	)
 }
 
+// updateActivePane passes the message to the active pane and keeps the rest of the UI in sync
+// with the item that is selected in it. On the credentials screen, the list of credentials is the active pane.
+func (model *Model) updateActivePane(msg tea.Msg) []tea.Cmd {
+	if model.screen == CredentialsScreen {
+		_, cmd := model.credentials.Update(msg)
+
+		return []tea.Cmd{cmd}
+	}
+
+	switch model.activePane {
+	case PackagesPane:
+		model.showArtifacts = false
+		selectedPackageID := model.selectedPackageID()
+		_, cmd := model.packages.Update(msg)
+
+		cmds := []tea.Cmd{
+			cmd,
+			model.attributes.AttributesCmd(model.packages.SelectedPackageAttributes()),
+		}
+
+		// Artifacts of the previously selected package are only cleared once another package is selected,
+		// not on every filter keystroke.
+		if model.selectedPackageID() != selectedPackageID {
+			model.artifacts.CancelCmds()
+			cmds = append(cmds,
+				model.artifacts.Init(),
+				model.tabs.Init(),
+			)
+		}
+
+		return cmds
+
+	case ArtifactsPane:
+		model.showArtifacts = true
+		_, cmd := model.artifacts.Update(msg)
+
+		return []tea.Cmd{
+			cmd,
+			model.attributes.AttributesCmd(model.artifacts.SelectedArtifactAttributes()),
+		}
+
+	default:
+		return nil
+	}
+}
+
+// selectedPackageID returns the ID of the selected content package, or an empty string if none is selected.
+func (model *Model) selectedPackageID() string {
+	if packageID := model.packages.SelectedPackageID(); packageID != nil {
+		return *packageID
+	}
+
+	return ""
+}
+
+// refreshedPane returns the name of the pane whose last refresh is displayed in the status bar: the pane
+// of the open screen, or the active pane of the workspace.
+func (model *Model) refreshedPane() string {
+	switch model.screen {
+	case MessagesScreen, MessageSearchScreen:
+		return "Messages"
+	case MessageDetailScreen:
+		return "Message"
+	case ConfigurationsScreen:
+		return "Configurations"
+	case ArchiveScreen:
+		return "Archive"
+	case KeystoreScreen:
+		return "Keystore"
+	case CredentialsScreen:
+		return "Credentials"
+	case DataStoresScreen:
+		return "Data stores"
+	case VariablesScreen:
+		return "Variables"
+	case QueuesScreen:
+		return "Queues"
+	}
+
+	if model.activePane == ArtifactsPane {
+		return "Artifacts"
+	}
+
+	return "Packages"
+}
+
+// closeScreen cancels loads of the screen that is open over the workspace, and of the screen the message
+// details were opened from, if any, and returns to the workspace.
+func (model *Model) closeScreen() {
+	model.messages.CancelCmds()
+	model.foundMessages.CancelCmds()
+	model.message.CancelCmds()
+	model.configurations.CancelCmds()
+	model.archive.CancelCmds()
+	model.keystore.CancelCmds()
+	model.credentials.CancelCmds()
+	model.datastores.CancelCmds()
+	model.variables.CancelCmds()
+	model.queues.CancelCmds()
+	model.pruneFailures()
+	model.screen = WorkspaceScreen
+}
+
+// pruneFailures forgets failed commands that can no longer be retried, because their loads have been
+// superseded or cancelled since.
+func (model *Model) pruneFailures() {
+	maps.DeleteFunc(model.failures, func(_ string, failure err.ErrorMsg) bool {
+		return !failure.Retryable()
+	})
+}
+
+// jumpTo selects the content package of the search result. If the result is an integration artifact,
+// artifacts of the package are loaded and the artifact is selected in the tab of its type.
+func (model *Model) jumpTo(entry searchpalette.Entry) []tea.Cmd {
+	model.closeScreen()
+
+	if !model.packages.SelectPackage(entry.PackageID) {
+		return []tea.Cmd{
+			statusbar.StatusMessageCmd(fmt.Sprintf("Content package %s is not loaded, refresh content packages", entry.PackageID)),
+		}
+	}
+
+	cmds := []tea.Cmd{
+		model.artifacts.Init(),
+		model.tabs.Init(),
+	}
+
+	if entry.ArtifactType == "" {
+		model.activePane = PackagesPane
+		model.showArtifacts = false
+
+		return append(cmds, model.attributes.AttributesCmd(model.packages.SelectedPackageAttributes()))
+	}
+
+	model.activePane = ArtifactsPane
+	model.showArtifacts = true
+	model.tabs.Update(tab.ActiveTabMsg(entry.ArtifactType))
+	model.artifacts.Update(tab.ActiveTabMsg(entry.ArtifactType))
+	model.artifacts.SelectArtifact(entry.ArtifactType, entry.ArtifactID)
+
+	return append(cmds, model.artifacts.IntegrationArtifactsByPackageCmd(entry.PackageID))
+}
+
+func (model *Model) filtering() bool {
+	if model.screen == CredentialsScreen {
+		return model.credentials.Filtering()
+	}
+
+	switch model.activePane {
+	case PackagesPane:
+		return model.packages.Filtering()
+	case ArtifactsPane:
+		return model.artifacts.Filtering()
+	default:
+		return false
+	}
+}
+
 func (model *Model) ToggleLayoutCmd() tea.Cmd {
 	return func() tea.Msg {
 		switch model.layout {
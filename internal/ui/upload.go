@@ -0,0 +1,105 @@
+package ui
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/vadimklimov/cpi-navigator/internal/config"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/searchpalette"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/statusbar"
+	"github.com/vadimklimov/cpi-navigator/internal/ui/components/upload"
+)
+
+// openUpload asks for the zip archive to update content of the artifact selected in the artifacts pane with.
+// While an upload is in progress, the form offers to cancel it instead.
+func (model *Model) openUpload() []tea.Cmd {
+	if model.upload.Uploading() {
+		return model.openUploadInProgress()
+	}
+
+	if model.activePane != ArtifactsPane || model.artifacts.SelectedArtifactID() == nil {
+		return nil
+	}
+
+	if !config.TenantWriteMode() {
+		return []tea.Cmd{
+			statusbar.StatusMessageCmd("Enable write mode in the configuration to upload artifacts"),
+		}
+	}
+
+	model.showUpload = true
+
+	return []tea.Cmd{model.upload.Open(upload.Target{
+		ArtifactType: model.artifacts.SelectedArtifactType(),
+		PackageID:    *model.artifacts.SelectedArtifactPackageID(),
+		ID:           *model.artifacts.SelectedArtifactID(),
+		Name:         *model.artifacts.SelectedArtifactName(),
+	})}
+}
+
+// openNewArtifact asks for the zip archive to create a new artifact in the selected content package from.
+// The artifact is of the type of the active tab of the artifacts pane. While an upload is in progress, the form
+// offers to cancel it instead.
+func (model *Model) openNewArtifact() []tea.Cmd {
+	if model.upload.Uploading() {
+		return model.openUploadInProgress()
+	}
+
+	if (model.activePane != PackagesPane && model.activePane != ArtifactsPane) ||
+		model.packages.SelectedPackageID() == nil {
+		return nil
+	}
+
+	if !config.TenantWriteMode() {
+		return []tea.Cmd{
+			statusbar.StatusMessageCmd("Enable write mode in the configuration to create artifacts"),
+		}
+	}
+
+	model.showUpload = true
+
+	return []tea.Cmd{model.upload.Open(upload.Target{
+		ArtifactType: model.artifacts.SelectedArtifactType(),
+		PackageID:    *model.packages.SelectedPackageID(),
+		Create:       true,
+	})}
+}
+
+// openUploadInProgress opens the form for the upload in progress, which asks whether to cancel it.
+func (model *Model) openUploadInProgress() []tea.Cmd {
+	model.showUpload = true
+
+	return []tea.Cmd{model.upload.Open(upload.Target{})}
+}
+
+// confirmUpload closes the upload form and asks to confirm the submitted upload.
+func (model *Model) confirmUpload(request upload.Request) {
+	model.showUpload = false
+
+	title := "Upload"
+	if request.Create {
+		title = "Create artifact"
+	}
+
+	model.confirm.Open(title, upload.Question(request), upload.UploadCmd(request))
+	model.showConfirm = true
+}
+
+// uploaded reports the uploaded artifact. If the workspace is displayed, artifacts of its content package
+// are reloaded and the artifact is selected, so that its new version is shown.
+func (model *Model) uploaded(msg upload.UploadedMsg) []tea.Cmd {
+	status := "Uploaded new content of " + msg.Name
+	if msg.Create {
+		status = "Created " + msg.Name
+	}
+
+	cmds := []tea.Cmd{statusbar.StatusMessageCmd(status)}
+
+	if model.screen == WorkspaceScreen {
+		cmds = append(cmds, model.jumpTo(searchpalette.Entry{
+			PackageID:    msg.PackageID,
+			ArtifactType: msg.ArtifactType,
+			ArtifactID:   msg.ID,
+		})...)
+	}
+
+	return cmds
+}
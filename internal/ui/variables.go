@@ -0,0 +1,37 @@
+package ui
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/vadimklimov/cpi-navigator/internal/cpi/api"
+)
+
+// openVariablesScreen lists variables of the runtime. If an integration flow is selected in the artifacts pane,
+// only its variables are listed at first.
+func (model *Model) openVariablesScreen() []tea.Cmd {
+	flowID := ""
+	if model.activePane == ArtifactsPane &&
+		model.artifacts.SelectedArtifactType() == api.SupportedArtifactTypes().Designtime.IntegrationFlow.Name &&
+		model.artifacts.SelectedArtifactID() != nil {
+		flowID = *model.artifacts.SelectedArtifactID()
+	}
+
+	model.screen = VariablesScreen
+
+	return []tea.Cmd{model.variables.Open(flowID)}
+}
+
+// updateVariablesScreen handles keys of the variables screen.
+func (model *Model) updateVariablesScreen(msg tea.KeyMsg) []tea.Cmd {
+	switch {
+	case key.Matches(msg, model.common.KeyMap.Close):
+		model.closeScreen()
+
+	default:
+		_, cmd := model.variables.Update(msg)
+
+		return []tea.Cmd{cmd}
+	}
+
+	return nil
+}
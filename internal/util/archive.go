@@ -0,0 +1,22 @@
+package util
+
+import (
+	"archive/zip"
+	"bytes"
+	"fmt"
+	"os"
+)
+
+// ReadArchive reads the zip archive from the file, making sure that it is a valid zip archive.
+func ReadArchive(path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading archive: %w", err)
+	}
+
+	if _, err := zip.NewReader(bytes.NewReader(content), int64(len(content))); err != nil {
+		return nil, fmt.Errorf("error reading archive %s: %w", path, err)
+	}
+
+	return content, nil
+}